@@ -0,0 +1,26 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+
+	"github.com/dertseha/goconsider/pkg/baseline"
+)
+
+// writeBaseline stores all issues, including already known ones, in a baseline file.
+func writeBaseline(filename string, issues []foundIssue) error {
+	absFilename, err := filepath.Abs(filename)
+	if err != nil {
+		return err
+	}
+	baseDir := filepath.Dir(absFilename)
+	entries := make([]baseline.Entry, 0, len(issues))
+	for _, issue := range issues {
+		entries = append(entries, baseline.EntryFor(baseDir, issue.Position.Filename, issue.Issue))
+	}
+	data, err := baseline.New(entries).ToYaml()
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(absFilename, data, 0o644)
+}
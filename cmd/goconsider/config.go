@@ -0,0 +1,53 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/dertseha/goconsider/pkg/settings"
+)
+
+const (
+	configCommand         = "config"
+	configValidateCommand = "validate"
+
+	defaultSettingsFilename = ".goconsider.yaml"
+)
+
+// configRequested returns true if the arguments start with the config command.
+func configRequested(args []string) bool {
+	return (len(args) > 0) && (args[0] == configCommand)
+}
+
+// runConfig runs a subcommand of the config command and returns the exit code.
+// Arguments are expected without the config command itself.
+func runConfig(args []string, stdout, stderr io.Writer) int {
+	if (len(args) == 0) || (args[0] != configValidateCommand) {
+		fmt.Fprintf(stderr, "Usage: goconsider %s %s [settings file...]\n", configCommand, configValidateCommand)
+		fmt.Fprintf(stderr, "Validates the given settings files, or '%s' in the current directory.\n", defaultSettingsFilename)
+		return exitCodeFailure
+	}
+	filenames := args[1:]
+	if len(filenames) == 0 {
+		filenames = []string{defaultSettingsFilename}
+	}
+	exitCode := exitCodeSuccess
+	for _, filename := range filenames {
+		err := settings.Validate(filename)
+		var validationErr *settings.ValidationError
+		switch {
+		case err == nil:
+			fmt.Fprintf(stdout, "%s: valid\n", filename)
+		case errors.As(err, &validationErr):
+			fmt.Fprintln(stderr, validationErr.Error())
+			if exitCode == exitCodeSuccess {
+				exitCode = exitCodeIssues
+			}
+		default:
+			fmt.Fprintf(stderr, "%s: %v\n", filename, err)
+			exitCode = exitCodeFailure
+		}
+	}
+	return exitCode
+}
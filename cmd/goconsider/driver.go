@@ -0,0 +1,299 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"go/token"
+	"io"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/dertseha/goconsider/pkg/analyzer"
+	"github.com/dertseha/goconsider/pkg/consider"
+	"github.com/dertseha/goconsider/pkg/report"
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/packages"
+)
+
+// driverFlagNames lists the flags that require the standalone driver.
+// The go/analysis checker only provides diagnostics per package, while these modes
+// need to process all issues of all packages at once.
+var driverFlagNames = map[string]bool{
+	"fail-on":        true,
+	"format":         true,
+	"write-baseline": true,
+}
+
+var (
+	errPackagesContainErrors = errors.New("packages contain errors")
+	errUnsupportedFlag       = errors.New("unsupported flag")
+	errUnknownFormat         = errors.New("unknown format")
+	errUnknownSeverity       = errors.New("unknown severity")
+)
+
+const (
+	exitCodeSuccess = 0
+	exitCodeFailure = 1
+	exitCodeIssues  = 3
+)
+
+type reportWriter func(w io.Writer, findings []report.Finding) error
+
+var reportWriters = map[string]reportWriter{
+	"text":       report.WriteText,
+	"json":       report.WriteJSON,
+	"sarif":      writeSARIF,
+	"checkstyle": report.WriteCheckstyle,
+	"junit":      report.WriteJUnit,
+}
+
+// writeSARIF writes the findings with paths relative to the current working directory.
+func writeSARIF(w io.Writer, findings []report.Finding) error {
+	baseDir, err := os.Getwd()
+	if err != nil {
+		baseDir = ""
+	}
+	return report.WriteSARIF(w, baseDir, findings)
+}
+
+// checkerFlags lists the flags of the go/analysis checker, which the driver does not support.
+// Each flag maps to whether it takes a value.
+var checkerFlags = map[string]bool{
+	"all":        false,
+	"c":          true,
+	"cpuprofile": true,
+	"debug":      true,
+	"fix":        false,
+	"flags":      false,
+	"json":       false,
+	"memprofile": true,
+	"source":     false,
+	"tags":       true,
+	"test":       false,
+	"trace":      true,
+	"v":          false,
+}
+
+// driverRequested returns true if any of the given arguments is a flag that requires the driver.
+func driverRequested(flags *flag.FlagSet, args []string) bool {
+	for _, name := range flagNames(flags, args) {
+		if driverFlagNames[name] {
+			return true
+		}
+	}
+	return false
+}
+
+// checkerFlagIn returns the name of the first flag of the go/analysis checker within given arguments, if any.
+func checkerFlagIn(flags *flag.FlagSet, args []string) (string, bool) {
+	for _, name := range flagNames(flags, args) {
+		if _, isCheckerFlag := checkerFlags[name]; isCheckerFlag && (flags.Lookup(name) == nil) {
+			return name, true
+		}
+	}
+	return "", false
+}
+
+// flagNames returns the names of the flags within given arguments.
+// The arguments are scanned up to the first package pattern. Values of flags, either of the given
+// flag set or of the go/analysis checker, are skipped.
+func flagNames(flags *flag.FlagSet, args []string) []string {
+	var names []string
+	for index := 0; index < len(args); index++ {
+		arg := args[index]
+		if arg == "--" || !strings.HasPrefix(arg, "-") {
+			break
+		}
+		name, _, hasValue := strings.Cut(strings.TrimLeft(arg, "-"), "=")
+		names = append(names, name)
+		if !hasValue && takesValue(flags, name) {
+			index++
+		}
+	}
+	return names
+}
+
+// takesValue returns true if the named flag takes a value as separate argument.
+func takesValue(flags *flag.FlagSet, name string) bool {
+	f := flags.Lookup(name)
+	if f == nil {
+		return checkerFlags[name]
+	}
+	boolFlag, isBoolFlag := f.Value.(interface{ IsBoolFlag() bool })
+	return !isBoolFlag || !boolFlag.IsBoolFlag()
+}
+
+// driverOptions are the parameters for the driver.
+type driverOptions struct {
+	format       string
+	baselineFile string
+	failOn       string
+}
+
+func registerDriverFlags(flags *flag.FlagSet) *driverOptions {
+	var opts driverOptions
+	flags.StringVar(&opts.format, "format", "text",
+		"format of the report: one of "+strings.Join(sortedKeys(reportWriters), ", "))
+	flags.StringVar(&opts.failOn, "fail-on", consider.SeverityInfo,
+		"lowest severity of findings that cause a failing exit code: one of error, warning, info")
+	flags.StringVar(&opts.baselineFile, "write-baseline", "",
+		"write all current issues to given baseline file and exit, instead of reporting them")
+	return &opts
+}
+
+// newDriverFlagSet returns a flag set with the flags of the analyzer and those of the driver.
+func newDriverFlagSet(an *analysis.Analyzer) (*flag.FlagSet, *driverOptions) {
+	flags := flag.NewFlagSet(an.Name, flag.ExitOnError)
+	an.Flags.VisitAll(func(f *flag.Flag) { flags.Var(f.Value, f.Name, f.Usage) })
+	return flags, registerDriverFlags(flags)
+}
+
+func sortedKeys(writers map[string]reportWriter) []string {
+	keys := make([]string, 0, len(writers))
+	for key := range writers {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// foundIssue is an issue together with its location.
+type foundIssue struct {
+	consider.Issue
+	Position  token.Position
+	Baselined bool
+}
+
+// runDriver runs the analyzer on the packages given by the arguments and returns the exit code.
+// Unless a baseline is written, the exit code signals whether issues were found.
+// Flags of the go/analysis checker, such as -fix or -json, are rejected, as the driver does not support them.
+func runDriver(an *analysis.Analyzer, args []string) int {
+	flags, opts := newDriverFlagSet(an)
+	if name, found := checkerFlagIn(flags, args); found {
+		fmt.Fprintf(flags.Output(), "%s: %v: -%s cannot be combined with -format, -fail-on, or -write-baseline\n",
+			an.Name, errUnsupportedFlag, name)
+		return exitCodeFailure
+	}
+	_ = flags.Parse(args)
+
+	exitCode, err := drive(an, opts, flags.Args())
+	if err != nil {
+		fmt.Fprintf(flags.Output(), "%s: %v\n", an.Name, err)
+		return exitCodeFailure
+	}
+	return exitCode
+}
+
+func drive(an *analysis.Analyzer, opts *driverOptions, patterns []string) (int, error) {
+	writer, known := reportWriters[opts.format]
+	if !known {
+		return exitCodeFailure, fmt.Errorf("%w: '%s'", errUnknownFormat, opts.format)
+	}
+	failRank, known := consider.SeverityRank(opts.failOn)
+	if !known {
+		return exitCodeFailure, fmt.Errorf("%w: '%s'", errUnknownSeverity, opts.failOn)
+	}
+	fset, issues, err := analyzePackages(an, patterns)
+	if err != nil {
+		return exitCodeFailure, err
+	}
+	if len(opts.baselineFile) != 0 {
+		return exitCodeSuccess, writeBaseline(opts.baselineFile, issues)
+	}
+	var findings []report.Finding
+	failing := false
+	for _, issue := range issues {
+		if issue.Baselined {
+			continue
+		}
+		finding := report.FindingFor(fset, issue.Issue)
+		findings = append(findings, finding)
+		if rank, _ := consider.SeverityRank(finding.Severity); rank >= failRank {
+			failing = true
+		}
+	}
+	if err := writer(os.Stdout, findings); err != nil {
+		return exitCodeFailure, err
+	}
+	if failing {
+		return exitCodeIssues, nil
+	}
+	return exitCodeSuccess, nil
+}
+
+// analyzePackages loads the packages of given patterns and returns the issues, sorted by position.
+// Packages are loaded including tests. Issues found in multiple variants of the same package are returned only once.
+func analyzePackages(an *analysis.Analyzer, patterns []string) (*token.FileSet, []foundIssue, error) {
+	fset := token.NewFileSet()
+	cfg := &packages.Config{
+		Mode: packages.NeedName | packages.NeedFiles | packages.NeedCompiledGoFiles | packages.NeedImports |
+			packages.NeedTypes | packages.NeedTypesSizes | packages.NeedSyntax | packages.NeedTypesInfo,
+		Tests: true,
+		Fset:  fset,
+	}
+	pkgs, err := packages.Load(cfg, patterns...)
+	if err != nil {
+		return nil, nil, err
+	}
+	if packages.PrintErrors(pkgs) > 0 {
+		return nil, nil, errPackagesContainErrors
+	}
+
+	var issues []foundIssue
+	seen := make(map[string]bool)
+	add := func(issue consider.Issue, baselined bool) {
+		position := fset.Position(issue.Pos)
+		key := fmt.Sprintf("%s:%d:%s", position.Filename, position.Offset, issue.Message)
+		if seen[key] {
+			return
+		}
+		seen[key] = true
+		issues = append(issues, foundIssue{Issue: issue, Position: position, Baselined: baselined})
+	}
+	for _, pkg := range pkgs {
+		if strings.HasSuffix(pkg.ID, ".test") {
+			continue
+		}
+		result, err := analyzePackage(an, fset, pkg)
+		if err != nil {
+			return nil, nil, fmt.Errorf("%s: %w", pkg.ID, err)
+		}
+		for _, issue := range result.Reported {
+			add(issue, false)
+		}
+		for _, issue := range result.Baselined {
+			add(issue, true)
+		}
+	}
+	sort.SliceStable(issues, func(a, b int) bool { return positionLess(issues[a].Position, issues[b].Position) })
+	return fset, issues, nil
+}
+
+func analyzePackage(an *analysis.Analyzer, fset *token.FileSet, pkg *packages.Package) (*analyzer.Result, error) {
+	pass := &analysis.Pass{
+		Analyzer:     an,
+		Fset:         fset,
+		Files:        pkg.Syntax,
+		OtherFiles:   pkg.OtherFiles,
+		IgnoredFiles: pkg.IgnoredFiles,
+		Pkg:          pkg.Types,
+		TypesInfo:    pkg.TypesInfo,
+		TypesSizes:   pkg.TypesSizes,
+		ResultOf:     make(map[*analysis.Analyzer]interface{}),
+		Report:       func(analysis.Diagnostic) {},
+	}
+	result, err := an.Run(pass)
+	if err != nil {
+		return nil, err
+	}
+	return result.(*analyzer.Result), nil
+}
+
+func positionLess(a, b token.Position) bool {
+	if a.Filename != b.Filename {
+		return a.Filename < b.Filename
+	}
+	return a.Offset < b.Offset
+}
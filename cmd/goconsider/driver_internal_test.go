@@ -0,0 +1,49 @@
+package main
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/dertseha/goconsider/pkg/analyzer"
+)
+
+func TestDriverRequested(t *testing.T) {
+	tests := []struct {
+		args     string
+		expected bool
+	}{
+		{args: "./...", expected: false},
+		{args: "-format json ./...", expected: true},
+		{args: "-format=json ./...", expected: true},
+		{args: "-settings x.yaml -format json ./...", expected: true},
+		{args: "-enable-tags a -fail-on error ./...", expected: true},
+		{args: "-c 1 -write-baseline known.yaml ./...", expected: true},
+		{args: "-settings x.yaml ./... -format json", expected: false},
+		{args: "-settings -format ./...", expected: false},
+	}
+	for _, tc := range tests {
+		flags, _ := newDriverFlagSet(analyzer.NewAnalyzerFromFlags())
+		if requested := driverRequested(flags, strings.Fields(tc.args)); requested != tc.expected {
+			t.Errorf("Arguments '%s' resulted in %v", tc.args, requested)
+		}
+	}
+}
+
+func TestCheckerFlagIn(t *testing.T) {
+	tests := []struct {
+		args     string
+		expected string
+	}{
+		{args: "-format json ./...", expected: ""},
+		{args: "-fix -format json ./...", expected: "fix"},
+		{args: "-format json -c 1 ./...", expected: "c"},
+		{args: "-settings -json -format text ./...", expected: ""},
+		{args: "-format text ./... -json", expected: ""},
+	}
+	for _, tc := range tests {
+		flags, _ := newDriverFlagSet(analyzer.NewAnalyzerFromFlags())
+		if name, _ := checkerFlagIn(flags, strings.Fields(tc.args)); name != tc.expected {
+			t.Errorf("Arguments '%s' resulted in '%s'", tc.args, name)
+		}
+	}
+}
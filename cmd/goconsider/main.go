@@ -2,12 +2,20 @@
 package main
 
 import (
+	"os"
+
 	"github.com/dertseha/goconsider/pkg/analyzer"
 	"golang.org/x/tools/go/analysis/singlechecker"
 )
 
 func main() {
+	if configRequested(os.Args[1:]) {
+		os.Exit(runConfig(os.Args[2:], os.Stdout, os.Stderr))
+	}
 	an := analyzer.NewAnalyzerFromFlags()
 	an.Flags.Var(versionFlag{}, "V", "print version and exit")
+	if flags, _ := newDriverFlagSet(an); driverRequested(flags, os.Args[1:]) {
+		os.Exit(runDriver(an, os.Args[1:]))
+	}
 	singlechecker.Main(an)
 }
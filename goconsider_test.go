@@ -23,6 +23,115 @@ func TestReporting(t *testing.T) {
 	analysistest.Run(t, testdataDir(t, "reporting"), analyzer.NewAnalyzer(settings), "./...")
 }
 
+func TestAllOccurrences(t *testing.T) {
+	settings := consider.Settings{
+		Phrases: []consider.Phrase{
+			{Synonyms: []string{"abcd"}, Alternatives: nil},
+			{Synonyms: []string{"abcd efgh"}, Alternatives: nil},
+		},
+		Occurrences: consider.OccurrencesAll,
+	}
+
+	analysistest.Run(t, testdataDir(t, "occurrences"), analyzer.NewAnalyzer(settings), "./...")
+}
+
+func TestDirectives(t *testing.T) {
+	settings := consider.Settings{
+		Phrases: []consider.Phrase{
+			{Synonyms: []string{"abcd", "abcds"}, Alternatives: nil},
+			{ID: "efgh-phrase", Synonyms: []string{"efgh"}, Alternatives: nil},
+		},
+	}
+
+	analysistest.Run(t, testdataDir(t, "directives"), analyzer.NewAnalyzer(settings), ".")
+}
+
+func TestDirectivesInGeneratedFiles(t *testing.T) {
+	checkComments := true
+	reportUnused := true
+	settings := consider.Settings{
+		Phrases: []consider.Phrase{
+			{Synonyms: []string{"abcd"}, Alternatives: nil},
+			{Synonyms: []string{"efgh"}, Alternatives: nil},
+		},
+		CheckGeneratedComments: &checkComments,
+		Directives:             consider.Directives{ReportUnused: &reportUnused},
+	}
+
+	analysistest.Run(t, testdataDir(t, "directives", "generated"), analyzer.NewAnalyzer(settings), "./...")
+}
+
+func TestUnusedDirectives(t *testing.T) {
+	reportUnused := true
+	settings := consider.Settings{
+		Phrases: []consider.Phrase{
+			{Synonyms: []string{"abcd"}, Alternatives: nil},
+			{Synonyms: []string{"efgh"}, Alternatives: nil},
+		},
+		Directives: consider.Directives{ReportUnused: &reportUnused},
+	}
+
+	analysistest.Run(t, testdataDir(t, "unusedDirectives"), analyzer.NewAnalyzer(settings), "./...")
+}
+
+func TestPaths(t *testing.T) {
+	settings := consider.Settings{
+		Phrases: []consider.Phrase{
+			{Synonyms: []string{"abcd"}, Exclude: []string{"*_special.go"}},
+			{Synonyms: []string{"efgh"}, Include: []string{"included/"}},
+		},
+		Exclude: []string{"third_party/"},
+	}
+
+	analysistest.Run(t, testdataDir(t, "paths"), analyzer.NewAnalyzer(settings), "./...")
+}
+
+func TestGeneratedFilesAreSkipped(t *testing.T) {
+	settings := consider.Settings{
+		Phrases: []consider.Phrase{
+			{Synonyms: []string{"abcd"}, Alternatives: nil},
+		},
+	}
+
+	analysistest.Run(t, testdataDir(t, "generated"), analyzer.NewAnalyzer(settings), "./...")
+}
+
+func TestGeneratedFilesWithComments(t *testing.T) {
+	checkComments := true
+	settings := consider.Settings{
+		Phrases: []consider.Phrase{
+			{Synonyms: []string{"abcd"}, Alternatives: nil},
+		},
+		CheckGeneratedComments: &checkComments,
+	}
+
+	analysistest.Run(t, testdataDir(t, "generatedComments"), analyzer.NewAnalyzer(settings), "./...")
+}
+
+func TestSuggestedFixes(t *testing.T) {
+	settings := consider.Settings{
+		Phrases: []consider.Phrase{
+			{Synonyms: []string{"bad"}, Alternatives: []string{"good", "fine one"}},
+		},
+	}
+
+	analysistest.RunWithSuggestedFixes(t, testdataDir(t, "fixes", "local"), analyzer.NewAnalyzer(settings), "./...")
+	analysistest.RunWithSuggestedFixes(t, testdataDir(t, "fixes", "fields"), analyzer.NewAnalyzer(settings), "./...")
+	analysistest.RunWithSuggestedFixes(t, testdataDir(t, "fixes", "interfaces"), analyzer.NewAnalyzer(settings), "./...")
+}
+
+func TestSuggestedFixesForExported(t *testing.T) {
+	renameExported := true
+	settings := consider.Settings{
+		Phrases: []consider.Phrase{
+			{Synonyms: []string{"bad"}, Alternatives: []string{"good"}},
+		},
+		Fixes: consider.Fixes{RenameExported: &renameExported},
+	}
+
+	analysistest.RunWithSuggestedFixes(t, testdataDir(t, "fixes", "exported"), analyzer.NewAnalyzer(settings), "./...")
+}
+
 func TestSettingsDefault(t *testing.T) {
 	cdWorkingDir(t, "settings", "default")
 	a := analyzer.NewAnalyzerFromFlags()
@@ -51,6 +160,95 @@ func TestSettingsWithReferences(t *testing.T) {
 	analysistest.Run(t, testdataDir(t, "settings", "references"), a, "./...")
 }
 
+func TestSettingsWithTemplate(t *testing.T) {
+	cdWorkingDir(t, "settings", "template")
+	a := analyzer.NewAnalyzerFromFlags()
+	_ = a.Flags.Parse([]string{})
+	analysistest.Run(t, testdataDir(t, "settings", "template"), a, "./...")
+}
+
+func TestSettingsWithTemplateFile(t *testing.T) {
+	cdWorkingDir(t, "settings", "templateFile")
+	a := analyzer.NewAnalyzerFromFlags()
+	_ = a.Flags.Parse([]string{"-settings", filepath.Join("config", "settings.yaml")})
+	analysistest.Run(t, testdataDir(t, "settings", "templateFile"), a, "./...")
+}
+
+func TestSettingsWithLocale(t *testing.T) {
+	cdWorkingDir(t, "settings", "locale")
+	a := analyzer.NewAnalyzerFromFlags()
+	_ = a.Flags.Parse([]string{})
+	analysistest.Run(t, testdataDir(t, "settings", "locale"), a, "./...")
+}
+
+func TestSettingsWithCatalog(t *testing.T) {
+	cdWorkingDir(t, "settings", "catalog")
+	a := analyzer.NewAnalyzerFromFlags()
+	_ = a.Flags.Parse([]string{})
+	analysistest.Run(t, testdataDir(t, "settings", "catalog"), a, "./...")
+}
+
+func TestSettingsWithTags(t *testing.T) {
+	cdWorkingDir(t, "settings", "tags")
+	a := analyzer.NewAnalyzerFromFlags()
+	_ = a.Flags.Parse([]string{"-enable-tags", "wording, terminology", "-disable-tags", "legacy,terminology"})
+	analysistest.Run(t, testdataDir(t, "settings", "tags"), a, "./...")
+}
+
+func TestSettingsAreDiscoveredPerDirectory(t *testing.T) {
+	a := analyzer.NewAnalyzerFromFlags()
+	_ = a.Flags.Parse([]string{})
+	analysistest.Run(t, testdataDir(t, "discovery"), a, "./...")
+}
+
+func TestBaseline(t *testing.T) {
+	cdWorkingDir(t, "baseline")
+	a := analyzer.NewAnalyzerFromFlags()
+	_ = a.Flags.Parse([]string{})
+	analysistest.Run(t, testdataDir(t, "baseline"), a, "./...")
+}
+
+func TestExternalNamesAreSuppressed(t *testing.T) {
+	settings := consider.Settings{
+		Phrases: []consider.Phrase{
+			{Synonyms: []string{"abcd"}},
+			{Synonyms: []string{"swap"}, Alternatives: []string{"exchange"}},
+		},
+	}
+
+	analysistest.Run(t, testdataDir(t, "externalNames", "suppressed"), analyzer.NewAnalyzer(settings), "./...")
+}
+
+func TestExternalNamesAreReportedWithSeverity(t *testing.T) {
+	settings := consider.Settings{
+		Phrases: []consider.Phrase{
+			{Synonyms: []string{"abcd"}},
+			{Synonyms: []string{"swap"}, Alternatives: []string{"exchange"}},
+		},
+		ExternalNames: consider.ExternalNames{Severity: consider.SeverityInfo},
+	}
+
+	results := analysistest.Run(t, testdataDir(t, "externalNames", "reported"), analyzer.NewAnalyzer(settings), "./...")
+	for _, result := range results {
+		for _, issue := range result.Result.(*analyzer.Result).Reported {
+			if (issue.Severity != consider.SeverityInfo) || (len(issue.Renames) != 0) {
+				t.Errorf("Unexpected issue %v", issue)
+			}
+		}
+	}
+}
+
+func TestStrings(t *testing.T) {
+	checkStrings := true
+	settings := consider.Settings{
+		Phrases:         []consider.Phrase{{Synonyms: []string{"abcd"}}},
+		CheckStrings:    &checkStrings,
+		StringFunctions: consider.StringFunctions{Errors: []string{"fmt.Sprintf"}},
+	}
+
+	analysistest.Run(t, testdataDir(t, "strings"), analyzer.NewAnalyzer(settings), "./...")
+}
+
 func cdWorkingDir(tb testing.TB, nested ...string) {
 	tb.Helper()
 	base := testBaseDir(tb)
@@ -0,0 +1,68 @@
+package text
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Rephrase replaces all occurrences of a phrase within an identifier with the given replacement.
+// The phrase is expected in its wordified form, such as "some phrase".
+// The replacement keeps the casing style of the identifier, be it camelCase, PascalCase, snake_case,
+// or SCREAMING_SNAKE_CASE.
+// The second return value is false if the phrase was not found in the identifier.
+func Rephrase(ident, phrase, replacement string) (string, bool) {
+	phraseWords := strings.Fields(phrase)
+	replacementWords := strings.Fields(strings.ToLower(replacement))
+	if (len(phraseWords) == 0) || (len(replacementWords) == 0) {
+		return ident, false
+	}
+	words := Words(ident)
+	snake := strings.Contains(ident, "_")
+
+	var result strings.Builder
+	last := 0
+	found := false
+	for i := 0; i+len(phraseWords) <= len(words); {
+		matched := words[i : i+len(phraseWords)]
+		if !wordsMatch(matched, phraseWords) {
+			i++
+			continue
+		}
+		first := matched[0]
+		result.WriteString(ident[last:first.Start])
+		result.WriteString(styled(replacementWords, ident[first.Start:first.End], snake))
+		last = matched[len(matched)-1].End
+		i += len(phraseWords)
+		found = true
+	}
+	result.WriteString(ident[last:])
+	return result.String(), found
+}
+
+func styled(words []string, original string, snake bool) string {
+	first, _ := utf8.DecodeRuneInString(original)
+	upper := (utf8.RuneCountInString(original) > 1) && (strings.ToUpper(original) == original)
+	title := unicode.IsUpper(first)
+	parts := make([]string, 0, len(words))
+	for index, word := range words {
+		switch {
+		case upper:
+			parts = append(parts, strings.ToUpper(word))
+		case title || ((index > 0) && !snake):
+			parts = append(parts, capitalized(word))
+		default:
+			parts = append(parts, word)
+		}
+	}
+	separator := ""
+	if snake {
+		separator = "_"
+	}
+	return strings.Join(parts, separator)
+}
+
+func capitalized(word string) string {
+	r, size := utf8.DecodeRuneInString(word)
+	return string(unicode.ToUpper(r)) + word[size:]
+}
@@ -0,0 +1,57 @@
+package text_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/dertseha/goconsider/internal/text"
+)
+
+func ExampleRephrase() {
+	r, _ := text.Rephrase("BadIndex", "bad", "good")
+	fmt.Println(r)
+	// Output:
+	// GoodIndex
+}
+
+func TestRephraseKeepsCasingStyle(t *testing.T) {
+	tt := []struct {
+		ident       string
+		phrase      string
+		replacement string
+		expected    string
+	}{
+		{ident: "badIndex", phrase: "bad", replacement: "good", expected: "goodIndex"},
+		{ident: "BadIndex", phrase: "bad", replacement: "good", expected: "GoodIndex"},
+		{ident: "theBad", phrase: "bad", replacement: "good", expected: "theGood"},
+		{ident: "bad_index", phrase: "bad", replacement: "good", expected: "good_index"},
+		{ident: "BAD_INDEX", phrase: "bad", replacement: "good", expected: "GOOD_INDEX"},
+		{ident: "BadIndex", phrase: "bad", replacement: "fine one", expected: "FineOneIndex"},
+		{ident: "badIndex", phrase: "bad", replacement: "fine one", expected: "fineOneIndex"},
+		{ident: "bad_index", phrase: "bad", replacement: "fine one", expected: "fine_one_index"},
+		{ident: "someBadThing", phrase: "bad thing", replacement: "item", expected: "someItem"},
+		{ident: "badBad", phrase: "bad", replacement: "good", expected: "goodGood"},
+	}
+	for _, tc := range tt {
+		td := tc
+		t.Run(td.ident+"->"+td.replacement, func(t *testing.T) {
+			result, found := text.Rephrase(td.ident, td.phrase, td.replacement)
+			if !found {
+				t.Errorf("Phrase not found")
+			}
+			if result != td.expected {
+				t.Errorf("Expected '%s', got '%s'", td.expected, result)
+			}
+		})
+	}
+}
+
+func TestRephraseReportsMissingPhrase(t *testing.T) {
+	result, found := text.Rephrase("badges", "bad", "good")
+	if found {
+		t.Errorf("Phrase should not be found in partial word")
+	}
+	if result != "badges" {
+		t.Errorf("Identifier should be unchanged, got '%s'", result)
+	}
+}
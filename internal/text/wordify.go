@@ -6,59 +6,21 @@ import (
 
 // Wordify processes a text and returns a simplified version of it.
 // It reduces all whitespace to single spaces and adds a single whitespace at the begin and end.
-// It splits up MixedCaseWords or hyphenated-words.
+// It splits up MixedCaseWords, hyphenated-words, or snake_case_words.
 // Any punctuation is replaced with whitespace.
 // Finally, it returns everything lowercase.
+//
+// Use Words to also know where each word is located in the original text.
 func Wordify(s string) string {
-	w := s
-	w = removePunctuation(w)
-	w = mergeBlanks(w)
-
-	oldwords := strings.Split(w, " ")
-	newwords := make([]string, 0, len(oldwords))
-	for _, word := range oldwords {
-		currentPart := ""
-		lastCase := runeCase(0)
-		addWord := func() {
-			if len(currentPart) == 0 {
-				return
-			}
-			newwords = append(newwords, currentPart)
-			currentPart = ""
-		}
-		for _, r := range word {
-			currentCase := runeCaseFrom(r)
-			newUpper := currentCase == 1 && lastCase != 1
-			newLower := currentCase == -1 && lastCase == 0
-			if newUpper || newLower {
-				addWord()
-			}
-			lastCase = currentCase
-			currentPart += string(r)
-		}
-		addWord()
-	}
-	if len(newwords) == 0 {
+	words := Words(s)
+	if len(words) == 0 {
 		return ""
 	}
-	return " " + strings.ToLower(strings.Join(newwords, " ")) + " "
-}
-
-func removePunctuation(w string) string {
-	for _, old := range []string{".", "?", "!", ";", ":", "-", "/", "(", ")", "\n", "\r"} {
-		w = strings.ReplaceAll(w, old, " ")
-	}
-	return w
-}
-
-func mergeBlanks(w string) string {
-	ref := w
-	w = strings.ReplaceAll(ref, "  ", " ")
-	for len(w) < len(ref) {
-		ref = w
-		w = strings.ReplaceAll(ref, "  ", " ")
+	texts := make([]string, 0, len(words))
+	for _, word := range words {
+		texts = append(texts, word.Text)
 	}
-	return w
+	return " " + strings.Join(texts, " ") + " "
 }
 
 type runeCase int
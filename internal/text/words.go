@@ -0,0 +1,88 @@
+package text
+
+import (
+	"strings"
+)
+
+// Word is a single, lowercase word of a text, together with its location in the original text.
+type Word struct {
+	// Text is the lowercase form of the word.
+	Text string
+	// Start is the byte offset of the first character of the word in the original text.
+	Start int
+	// End is the byte offset just after the last character of the word in the original text.
+	End int
+}
+
+// Words splits a text into words, following the rules of Wordify.
+// In contrast to Wordify, it keeps track of where each word is located in the original text.
+func Words(s string) []Word {
+	var words []Word
+	start := -1
+	lastCase := runeCase(0)
+	addWord := func(end int) {
+		if (start >= 0) && (start < end) {
+			words = append(words, Word{Text: strings.ToLower(s[start:end]), Start: start, End: end})
+		}
+		start = -1
+	}
+	for offset, r := range s {
+		if isSeparator(r) {
+			addWord(offset)
+			lastCase = 0
+			continue
+		}
+		currentCase := runeCaseFrom(r)
+		newUpper := currentCase == 1 && lastCase != 1
+		newLower := currentCase == -1 && lastCase == 0
+		if newUpper || newLower {
+			addWord(offset)
+		}
+		if start < 0 {
+			start = offset
+		}
+		lastCase = currentCase
+	}
+	addWord(len(s))
+	return words
+}
+
+func isSeparator(r rune) bool {
+	return strings.ContainsRune(" \t.?!;:-_/()\n\r", r)
+}
+
+// Match describes the location of a found phrase in the original text.
+type Match struct {
+	// Start is the byte offset of the first character of the phrase in the original text.
+	Start int
+	// End is the byte offset just after the last character of the phrase in the original text.
+	End int
+}
+
+// FindAll returns the locations of all non-overlapping occurrences of a phrase within the words.
+// The phrase is expected in its wordified form, such as "some phrase".
+func FindAll(words []Word, phrase string) []Match {
+	phraseWords := strings.Fields(phrase)
+	if len(phraseWords) == 0 {
+		return nil
+	}
+	var matches []Match
+	for i := 0; i+len(phraseWords) <= len(words); {
+		if !wordsMatch(words[i:i+len(phraseWords)], phraseWords) {
+			i++
+			continue
+		}
+		matches = append(matches, Match{Start: words[i].Start, End: words[i+len(phraseWords)-1].End})
+		i += len(phraseWords)
+	}
+	return matches
+}
+
+func wordsMatch(words []Word, phraseWords []string) bool {
+	for index, phraseWord := range phraseWords {
+		if words[index].Text != phraseWord {
+			return false
+		}
+	}
+	return true
+}
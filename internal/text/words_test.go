@@ -0,0 +1,49 @@
+package text_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/dertseha/goconsider/internal/text"
+)
+
+func TestWordsKeepLocations(t *testing.T) {
+	words := text.Words("Some mixedCase\n  words.")
+	expected := []text.Word{
+		{Text: "some", Start: 0, End: 4},
+		{Text: "mixed", Start: 5, End: 10},
+		{Text: "case", Start: 10, End: 14},
+		{Text: "words", Start: 17, End: 22},
+	}
+	if !reflect.DeepEqual(words, expected) {
+		t.Errorf("Unexpected words %v", words)
+	}
+}
+
+func TestFindAllReturnsLocationsOfOccurrences(t *testing.T) {
+	words := text.Words("one two three two three")
+	matches := text.FindAll(words, "two three")
+	expected := []text.Match{{Start: 4, End: 13}, {Start: 14, End: 23}}
+	if !reflect.DeepEqual(matches, expected) {
+		t.Errorf("Unexpected matches %v", matches)
+	}
+}
+
+func TestFindAllIgnoresPartialWords(t *testing.T) {
+	if matches := text.FindAll(text.Words("twofold three"), "two"); len(matches) != 0 {
+		t.Errorf("Unexpected matches %v", matches)
+	}
+}
+
+func TestFindAllReturnsNonOverlappingOccurrences(t *testing.T) {
+	words := text.Words("two two two three two")
+	matches := text.FindAll(words, "two two")
+	expected := []text.Match{{Start: 0, End: 7}}
+	if !reflect.DeepEqual(matches, expected) {
+		t.Errorf("Unexpected matches %v", matches)
+	}
+	matches = text.FindAll(words, "two")
+	if len(matches) != 4 {
+		t.Errorf("Unexpected matches %v", matches)
+	}
+}
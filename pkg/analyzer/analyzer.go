@@ -1,9 +1,8 @@
 package analyzer
 
 import (
-	"go/token"
-	"os"
-	"path"
+	"reflect"
+	"strings"
 
 	"github.com/dertseha/goconsider/pkg/consider"
 	"github.com/dertseha/goconsider/pkg/settings"
@@ -20,90 +19,151 @@ const (
 // NewAnalyzer returns a new instance with the given settings.
 func NewAnalyzer(s consider.Settings) *analysis.Analyzer {
 	an := newBaseAnalyzer()
-	an.Run = runnerWithSettingsFrom(func() (consider.Settings, error) { return s, nil })
+	an.Run = runnerWithSettingsFrom(func(*analysis.Pass) (consider.Settings, error) { return s, nil })
 	return an
 }
 
 // NewAnalyzerFromSettingsFile returns a new instance that will load the settings from a file at given path.
-// If the given string is empty, defaults will apply.
+// If the given string is empty, the settings are discovered for each package, see NewAnalyzerFromFlags.
 func NewAnalyzerFromSettingsFile(settingsFile string) *analysis.Analyzer {
 	an := newBaseAnalyzer()
-	an.Run = runnerWithSettingsFrom(func() (consider.Settings, error) { return resolveSettings(settingsFile) })
+	discovery := newSettingsDiscovery()
+	an.Run = runnerWithSettingsFrom(func(pass *analysis.Pass) (consider.Settings, error) {
+		return resolveSettings(settingsFile, discovery, pass)
+	})
 	return an
 }
 
 // NewAnalyzerFromFlags returns an instance that defers to configuration via flags.
+//
+// Without an explicit settings file, the settings are discovered for each package: Files named
+// ".goconsider.yaml" are looked for in the directory of the package and its parents, up to the root of the module,
+// which is the directory containing the "go.mod" file. Settings of nearer directories are merged over those
+// of farther ones. If no file is found, the default settings apply.
 func NewAnalyzerFromFlags() *analysis.Analyzer {
 	an := newBaseAnalyzer()
 	settingsFile := an.Flags.String("settings", "",
-		"name of a settings file (defaults to '"+implicitSettingsFilename+"' in current working directory)")
-	an.Run = runnerWithSettingsFrom(func() (consider.Settings, error) { return resolveSettings(*settingsFile) })
+		"name of a settings file (defaults to '"+implicitSettingsFilename+"' files in the package directory and its parents)")
+	enableTags := an.Flags.String("enable-tags", "",
+		"comma-separated list of tags: only phrases with any of them are looked for (overrides settings)")
+	disableTags := an.Flags.String("disable-tags", "",
+		"comma-separated list of tags: phrases with any of them are not looked for (overrides settings)")
+	discovery := newSettingsDiscovery()
+	an.Run = runnerWithSettingsFrom(func(pass *analysis.Pass) (consider.Settings, error) {
+		s, err := resolveSettings(*settingsFile, discovery, pass)
+		if err != nil {
+			return s, err
+		}
+		if len(*enableTags) != 0 {
+			s.EnableTags = tagList(*enableTags)
+		}
+		if len(*disableTags) != 0 {
+			s.DisableTags = tagList(*disableTags)
+		}
+		return s, nil
+	})
 	return an
 }
 
+// tagList splits a comma-separated list of tags.
+func tagList(s string) []string {
+	var tags []string
+	for _, tag := range strings.Split(s, ",") {
+		if tag = strings.TrimSpace(tag); len(tag) != 0 {
+			tags = append(tags, tag)
+		}
+	}
+	return tags
+}
+
+// Result is the outcome of the analyzer for one package.
+type Result struct {
+	// Reported are the issues that were reported as diagnostics.
+	Reported []consider.Issue
+	// Baselined are the issues that were not reported, as they are known from the baseline.
+	Baselined []consider.Issue
+}
+
 func newBaseAnalyzer() *analysis.Analyzer {
 	return &analysis.Analyzer{
-		Name: analyzerName,
-		Doc:  documentation,
+		Name:       analyzerName,
+		Doc:        documentation,
+		ResultType: reflect.TypeOf((*Result)(nil)),
 	}
 }
 
-func runnerWithSettingsFrom(factory func() (consider.Settings, error)) func(*analysis.Pass) (interface{}, error) {
+func runnerWithSettingsFrom(factory func(*analysis.Pass) (consider.Settings, error)) func(*analysis.Pass) (interface{}, error) {
 	return func(pass *analysis.Pass) (interface{}, error) {
-		s, err := factory()
+		s, err := factory(pass)
 		if err != nil {
 			return nil, err
 		}
+		s, err = settings.LoadFormattingFiles(s)
+		if err != nil {
+			return nil, err
+		}
+		if err := s.Validate(); err != nil {
+			return nil, err
+		}
 		return run(s, pass)
 	}
 }
 
-func resolveSettings(settingsFile string) (consider.Settings, error) {
+func resolveSettings(settingsFile string, discovery *settingsDiscovery, pass *analysis.Pass) (consider.Settings, error) {
 	if len(settingsFile) != 0 {
 		return readSettings(settingsFile)
 	}
-	return defaultSettings()
-}
-
-func defaultSettings() (consider.Settings, error) {
-	filename := path.Join(".", implicitSettingsFilename)
-	if _, err := os.Stat(filename); err != nil {
+	dir, found := packageDir(pass)
+	if !found {
 		return settings.Default(), nil
 	}
-	return readSettings(filename)
+	return discovery.settingsFor(dir)
 }
 
 func readSettings(settingsFile string) (consider.Settings, error) {
-	settingsData, err := os.ReadFile(settingsFile)
-	if err != nil {
-		return consider.Settings{}, err
-	}
-	s, err := settings.FromYaml(settingsData)
-	if err != nil {
-		return consider.Settings{}, err
-	}
-	return s, nil
+	return settings.FromFile(settingsFile)
 }
 
 func run(settings consider.Settings, pass *analysis.Pass) (interface{}, error) {
+	known, err := newBaselineFilter(settings, pass)
+	if err != nil {
+		return nil, err
+	}
+	result := &Result{}
+	report := reporterFuncFor(pass, settings)
+	module, moduleDir := moduleOf(pass)
 	for _, f := range pass.Files {
-		linter := consider.NewLinter(settings, reporterFuncFor(pass))
+		linter := consider.NewLinter(settings, reporterFunc(func(issue consider.Issue) {
+			if known.known(issue) {
+				result.Baselined = append(result.Baselined, issue)
+				return
+			}
+			result.Reported = append(result.Reported, issue)
+			report(issue)
+		}))
+		linter.SetTypesInfo(pass.TypesInfo)
+		linter.SetPackageFiles(pass.Files)
+		linter.SetModulePath(module)
+		linter.SetRootDir(moduleDir)
 		linter.CheckFile(f, pass.Fset.File(f.Package))
 	}
-	return nil, nil
+	return result, nil
 }
 
-type reporterFunc func(pos token.Pos, message string)
+type reporterFunc func(issue consider.Issue)
 
-func (f reporterFunc) Report(pos token.Pos, message string) {
-	f(pos, message)
+func (f reporterFunc) Report(issue consider.Issue) {
+	f(issue)
 }
 
-func reporterFuncFor(pass *analysis.Pass) reporterFunc {
-	return func(pos token.Pos, message string) {
+func reporterFuncFor(pass *analysis.Pass, settings consider.Settings) reporterFunc {
+	return func(issue consider.Issue) {
 		pass.Report(analysis.Diagnostic{
-			Pos:     pos,
-			Message: message,
+			Pos:            issue.Pos,
+			End:            issue.End,
+			Message:        issue.Message,
+			Category:       issue.PhraseID,
+			SuggestedFixes: suggestedFixesFor(pass, settings, issue),
 		})
 	}
 }
@@ -0,0 +1,48 @@
+package analyzer
+
+import (
+	"os"
+	"path/filepath"
+
+	"github.com/dertseha/goconsider/pkg/baseline"
+	"github.com/dertseha/goconsider/pkg/consider"
+	"golang.org/x/tools/go/analysis"
+)
+
+// baselineFilter filters the issues of a pass that are known from a baseline.
+type baselineFilter struct {
+	pass    *analysis.Pass
+	baseDir string
+	filter  *baseline.Filter
+}
+
+func newBaselineFilter(settings consider.Settings, pass *analysis.Pass) (*baselineFilter, error) {
+	if len(settings.Baseline) == 0 {
+		return &baselineFilter{}, nil
+	}
+	filename, err := filepath.Abs(settings.Baseline)
+	if err != nil {
+		return nil, err
+	}
+	data, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+	b, err := baseline.FromYaml(data)
+	if err != nil {
+		return nil, err
+	}
+	return &baselineFilter{
+		pass:    pass,
+		baseDir: filepath.Dir(filename),
+		filter:  b.NewFilter(),
+	}, nil
+}
+
+func (f *baselineFilter) known(issue consider.Issue) bool {
+	if f.filter == nil {
+		return false
+	}
+	filename := f.pass.Fset.Position(issue.Pos).Filename
+	return f.filter.Known(baseline.EntryFor(f.baseDir, filename, issue))
+}
@@ -0,0 +1,137 @@
+package analyzer
+
+import (
+	"go/build"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/dertseha/goconsider/pkg/consider"
+	"github.com/dertseha/goconsider/pkg/settings"
+	"golang.org/x/tools/go/analysis"
+)
+
+const moduleFilename = "go.mod"
+
+// settingsDiscovery resolves the implicit settings of directories, and caches them.
+type settingsDiscovery struct {
+	mutex    sync.Mutex
+	resolved map[string]discoveredSettings
+}
+
+type discoveredSettings struct {
+	settings consider.Settings
+	found    bool
+	err      error
+}
+
+func newSettingsDiscovery() *settingsDiscovery {
+	return &settingsDiscovery{resolved: make(map[string]discoveredSettings)}
+}
+
+// settingsFor returns the settings that apply to files in given directory.
+// The default settings apply if no settings files are found.
+func (d *settingsDiscovery) settingsFor(dir string) (consider.Settings, error) {
+	absolute, err := filepath.Abs(dir)
+	if err != nil {
+		return consider.Settings{}, err
+	}
+	result := d.resolve(absolute, discoveryRootOf(absolute, gopathSourceDirs()))
+	if result.err != nil {
+		return consider.Settings{}, result.err
+	}
+	if !result.found {
+		return settings.Default(), nil
+	}
+	return result.settings, nil
+}
+
+// resolve merges the settings file of the directory, if it exists, over those of the parent directories.
+// The parents are not considered beyond given root directory.
+func (d *settingsDiscovery) resolve(dir string, root string) discoveredSettings {
+	d.mutex.Lock()
+	cached, known := d.resolved[dir]
+	d.mutex.Unlock()
+	if known {
+		return cached
+	}
+
+	var result discoveredSettings
+	parent := filepath.Dir(dir)
+	if (dir != root) && (parent != dir) {
+		result = d.resolve(parent, root)
+	}
+	filename := filepath.Join(dir, implicitSettingsFilename)
+	if (result.err == nil) && fileExists(filename) {
+		own, err := readSettings(filename)
+		switch {
+		case err != nil:
+			result.err = err
+		case result.found:
+			result.settings = settings.Merge(result.settings, own)
+		default:
+			result.settings = own
+			result.found = true
+		}
+	}
+
+	d.mutex.Lock()
+	d.resolved[dir] = result
+	d.mutex.Unlock()
+	return result
+}
+
+// discoveryRootOf returns the directory at which the discovery of settings ends for given directory.
+// This is the root of the module, which contains the "go.mod" file. Without a module, it is the one of the
+// source directories of the GOPATH that contains the directory. If neither exists, only the directory itself
+// is considered.
+func discoveryRootOf(dir string, sourceDirs []string) string {
+	current := dir
+	for {
+		if fileExists(filepath.Join(current, moduleFilename)) || containsString(sourceDirs, current) {
+			return current
+		}
+		parent := filepath.Dir(current)
+		if parent == current {
+			return dir
+		}
+		current = parent
+	}
+}
+
+// gopathSourceDirs returns the absolute "src" directories of all GOPATH entries.
+func gopathSourceDirs() []string {
+	var dirs []string
+	for _, entry := range filepath.SplitList(build.Default.GOPATH) {
+		if absolute, err := filepath.Abs(entry); err == nil {
+			dirs = append(dirs, filepath.Join(absolute, "src"))
+		}
+	}
+	return dirs
+}
+
+func containsString(list []string, s string) bool {
+	for _, entry := range list {
+		if entry == s {
+			return true
+		}
+	}
+	return false
+}
+
+func fileExists(filename string) bool {
+	info, err := os.Stat(filename)
+	return (err == nil) && !info.IsDir()
+}
+
+// packageDir returns the directory of the source files of the package in the pass.
+func packageDir(pass *analysis.Pass) (string, bool) {
+	for _, f := range pass.Files {
+		filename := pass.Fset.Position(f.Package).Filename
+		if strings.HasSuffix(filename, ".go") {
+			return filepath.Dir(filename), true
+		}
+	}
+	return "", false
+}
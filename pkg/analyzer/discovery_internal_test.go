@@ -0,0 +1,34 @@
+package analyzer
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestDiscoveryRootOf(t *testing.T) {
+	base := t.TempDir()
+	moduleDir := filepath.Join(base, "module")
+	sourceDir := filepath.Join(base, "gopath", "src")
+	for _, dir := range []string{filepath.Join(moduleDir, "pkg"), filepath.Join(sourceDir, "pkg"), filepath.Join(base, "other")} {
+		if err := os.MkdirAll(dir, 0o700); err != nil {
+			t.Fatalf("Failed to create directory: %v", err)
+		}
+	}
+	if err := os.WriteFile(filepath.Join(moduleDir, moduleFilename), []byte("module abcd\n"), 0o600); err != nil {
+		t.Fatalf("Failed to write file: %v", err)
+	}
+	tests := []struct {
+		dir      string
+		expected string
+	}{
+		{dir: filepath.Join(moduleDir, "pkg"), expected: moduleDir},
+		{dir: filepath.Join(sourceDir, "pkg"), expected: sourceDir},
+		{dir: filepath.Join(base, "other"), expected: filepath.Join(base, "other")},
+	}
+	for _, tc := range tests {
+		if root := discoveryRootOf(tc.dir, []string{sourceDir}); root != tc.expected {
+			t.Errorf("Directory %s has unexpected root %s", tc.dir, root)
+		}
+	}
+}
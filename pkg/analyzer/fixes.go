@@ -0,0 +1,183 @@
+package analyzer
+
+import (
+	"fmt"
+	"go/ast"
+	"go/types"
+	"sort"
+
+	"github.com/dertseha/goconsider/pkg/consider"
+	"golang.org/x/tools/go/analysis"
+)
+
+// suggestedFixesFor returns the renames of an issue as fixes.
+// Each fix renames the declaration and all uses of the identifier within the package.
+// No fixes are provided if the identifier cannot be resolved, or if a rename would break code.
+// This includes methods that implement an interface of the package, as well as the methods of such interfaces.
+func suggestedFixesFor(pass *analysis.Pass, settings consider.Settings, issue consider.Issue) []analysis.SuggestedFix {
+	if (issue.Ident == nil) || (pass.TypesInfo == nil) {
+		return nil
+	}
+	obj := pass.TypesInfo.ObjectOf(issue.Ident)
+	if obj == nil {
+		return nil
+	}
+	if isVisibleOutsidePackage(obj) && !settings.Fixes.RenamesExported() {
+		return nil
+	}
+	idents := identsReferringTo(pass.TypesInfo, obj)
+	fixes := make([]analysis.SuggestedFix, 0, len(issue.Renames))
+	for _, rename := range issue.Renames {
+		if renameConflicts(pass, obj, idents, rename) {
+			continue
+		}
+		fix := analysis.SuggestedFix{
+			Message: fmt.Sprintf("Rename '%s' to '%s'", issue.Ident.Name, rename),
+		}
+		for _, ident := range idents {
+			fix.TextEdits = append(fix.TextEdits, analysis.TextEdit{Pos: ident.Pos(), End: ident.End(), NewText: []byte(rename)})
+		}
+		fixes = append(fixes, fix)
+	}
+	return fixes
+}
+
+// identsReferringTo returns all identifiers that either define or use the given object, sorted by position.
+func identsReferringTo(info *types.Info, obj types.Object) []*ast.Ident {
+	var idents []*ast.Ident
+	for ident, def := range info.Defs {
+		if def == obj {
+			idents = append(idents, ident)
+		}
+	}
+	for ident, use := range info.Uses {
+		if use == obj {
+			idents = append(idents, ident)
+		}
+	}
+	sort.Slice(idents, func(a, b int) bool { return idents[a].Pos() < idents[b].Pos() })
+	return idents
+}
+
+// isVisibleOutsidePackage returns true for exported objects that other packages can refer to.
+// These are exported package-level objects, as well as exported fields and methods.
+func isVisibleOutsidePackage(obj types.Object) bool {
+	if !obj.Exported() || (obj.Pkg() == nil) {
+		return false
+	}
+	parent := obj.Parent()
+	return (parent == nil) || (parent == obj.Pkg().Scope())
+}
+
+// renameConflicts returns true if the new name would collide with, or be shadowed by, another object.
+func renameConflicts(pass *analysis.Pass, obj types.Object, idents []*ast.Ident, rename string) bool {
+	if fn, isFunc := obj.(*types.Func); isFunc {
+		if recv := fn.Type().(*types.Signature).Recv(); recv != nil {
+			if tiedByInterface(pass.TypesInfo, fn, recv.Type()) {
+				return true
+			}
+			existing, _, _ := types.LookupFieldOrMethod(recv.Type(), true, obj.Pkg(), rename)
+			return existing != nil
+		}
+	}
+	if field, isVar := obj.(*types.Var); isVar && field.IsField() {
+		owner := fieldOwner(pass.TypesInfo, field)
+		if owner == nil {
+			return true
+		}
+		existing, _, _ := types.LookupFieldOrMethod(owner, true, obj.Pkg(), rename)
+		return existing != nil
+	}
+	if (obj.Parent() != nil) && (obj.Parent().Lookup(rename) != nil) {
+		return true
+	}
+	for _, ident := range idents {
+		scope := pass.Pkg.Scope().Innermost(ident.Pos())
+		if scope == nil {
+			continue
+		}
+		if _, existing := scope.LookupParent(rename, ident.Pos()); existing != nil {
+			return true
+		}
+	}
+	return false
+}
+
+// fieldOwner returns the type that declares the given field: The named type of the struct, if any, so that
+// its methods are considered as well, or the struct itself. It returns nil if the struct is not found.
+func fieldOwner(info *types.Info, field *types.Var) types.Type {
+	var owner *types.Struct
+	for _, tv := range info.Types {
+		if structType, isStruct := tv.Type.(*types.Struct); isStruct && structDeclares(structType, field) {
+			owner = structType
+			break
+		}
+	}
+	if owner == nil {
+		return nil
+	}
+	for _, def := range info.Defs {
+		if typeName, isTypeName := def.(*types.TypeName); isTypeName && (typeName.Type().Underlying() == owner) {
+			if _, isNamed := typeName.Type().(*types.Named); isNamed {
+				return typeName.Type()
+			}
+		}
+	}
+	return owner
+}
+
+func structDeclares(structType *types.Struct, field *types.Var) bool {
+	for index := 0; index < structType.NumFields(); index++ {
+		if structType.Field(index) == field {
+			return true
+		}
+	}
+	return false
+}
+
+// tiedByInterface returns true if the method of given receiver type is tied to other methods by an interface
+// of the package: Either the method is declared by such an interface that a type of the package implements,
+// or the receiver type implements such an interface that requires the method.
+// Renaming only one side would break the implementation.
+func tiedByInterface(info *types.Info, method *types.Func, recvType types.Type) bool {
+	var interfaces []*types.Interface
+	var concreteTypes []types.Type
+	for _, def := range info.Defs {
+		typeName, isTypeName := def.(*types.TypeName)
+		if !isTypeName || typeName.IsAlias() {
+			continue
+		}
+		named, isNamed := typeName.Type().(*types.Named)
+		if !isNamed || (named.TypeParams().Len() != 0) {
+			continue
+		}
+		if iface, isInterface := named.Underlying().(*types.Interface); isInterface {
+			interfaces = append(interfaces, iface)
+		} else {
+			concreteTypes = append(concreteTypes, named)
+		}
+	}
+	if iface, isInterface := recvType.Underlying().(*types.Interface); isInterface {
+		for _, concreteType := range concreteTypes {
+			if implementsInterface(concreteType, iface) {
+				return true
+			}
+		}
+		return false
+	}
+	if pointer, isPointer := recvType.(*types.Pointer); isPointer {
+		recvType = pointer.Elem()
+	}
+	for _, iface := range interfaces {
+		required, _, _ := types.LookupFieldOrMethod(iface, false, method.Pkg(), method.Name())
+		if (required != nil) && implementsInterface(recvType, iface) {
+			return true
+		}
+	}
+	return false
+}
+
+// implementsInterface returns true if given type, or a pointer to it, implements the interface.
+func implementsInterface(t types.Type, iface *types.Interface) bool {
+	return types.Implements(t, iface) || types.Implements(types.NewPointer(t), iface)
+}
@@ -0,0 +1,51 @@
+package analyzer
+
+import (
+	"bufio"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"golang.org/x/tools/go/analysis"
+)
+
+// moduleOf returns the path of the module that contains the package of the pass, as declared in the "go.mod" file,
+// together with the directory of that file. Empty strings are returned if the module is unknown.
+func moduleOf(pass *analysis.Pass) (path string, dir string) {
+	dir, found := packageDir(pass)
+	if !found {
+		return "", ""
+	}
+	dir, err := filepath.Abs(dir)
+	if err != nil {
+		return "", ""
+	}
+	for {
+		filename := filepath.Join(dir, moduleFilename)
+		if fileExists(filename) {
+			return modulePathFrom(filename), dir
+		}
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			return "", ""
+		}
+		dir = parent
+	}
+}
+
+// modulePathFrom returns the path of the module directive in given "go.mod" file.
+func modulePathFrom(filename string) string {
+	file, err := os.Open(filename)
+	if err != nil {
+		return ""
+	}
+	defer func() { _ = file.Close() }()
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if (len(fields) >= 2) && (fields[0] == "module") {
+			return strings.Trim(fields[1], "\"`")
+		}
+	}
+	return ""
+}
@@ -0,0 +1,131 @@
+package baseline
+
+import (
+	"path/filepath"
+	"sort"
+
+	"github.com/dertseha/goconsider/pkg/consider"
+	"gopkg.in/yaml.v3"
+)
+
+// Baseline is a record of known issues.
+type Baseline struct {
+	// Issues lists the known issues.
+	Issues []Entry `yaml:"issues"`
+}
+
+// Entry identifies one or more issues that share the same properties.
+// Entries are intentionally independent of line numbers, so that they remain valid if unrelated code changes.
+type Entry struct {
+	// File is the slash-separated path of the file, relative to the directory of the baseline.
+	File string `yaml:"file"`
+	// Declaration is the name of the top-level declaration containing the issue, if any.
+	Declaration string `yaml:"declaration,omitempty"`
+	// Context describes where the phrase was found, such as "Type name".
+	Context string `yaml:"context"`
+	// Found is the synonym that was found.
+	Found string `yaml:"found"`
+	// Phrase is the identifier of the phrase that was found.
+	Phrase string `yaml:"phrase,omitempty"`
+	// Count is the number of issues with the same properties.
+	Count int `yaml:"count"`
+}
+
+func (entry Entry) key() Entry {
+	entry.Count = 0
+	return entry
+}
+
+// EntryFor returns the entry for a single issue found in given file.
+// The baseDir is the directory of the baseline file. Both paths are expected to be absolute.
+func EntryFor(baseDir string, filename string, issue consider.Issue) Entry {
+	file := filename
+	if relative, err := filepath.Rel(baseDir, filename); err == nil {
+		file = relative
+	}
+	return Entry{
+		File:        filepath.ToSlash(file),
+		Declaration: issue.Declaration,
+		Context:     issue.Context,
+		Found:       issue.Found,
+		Phrase:      issue.PhraseID,
+		Count:       1,
+	}
+}
+
+// New returns a baseline of given entries.
+// Entries with the same properties are merged and their counts are summed up.
+// The resulting entries are sorted.
+func New(entries []Entry) Baseline {
+	counts := make(map[Entry]int)
+	for _, entry := range entries {
+		counts[entry.key()] += entry.Count
+	}
+	b := Baseline{Issues: make([]Entry, 0, len(counts))}
+	for key, count := range counts {
+		key.Count = count
+		b.Issues = append(b.Issues, key)
+	}
+	sort.Slice(b.Issues, func(i, j int) bool { return b.Issues[i].less(b.Issues[j]) })
+	return b
+}
+
+func (entry Entry) less(other Entry) bool {
+	if entry.File != other.File {
+		return entry.File < other.File
+	}
+	if entry.Declaration != other.Declaration {
+		return entry.Declaration < other.Declaration
+	}
+	if entry.Context != other.Context {
+		return entry.Context < other.Context
+	}
+	if entry.Found != other.Found {
+		return entry.Found < other.Found
+	}
+	return entry.Phrase < other.Phrase
+}
+
+// FromYaml parses the provided raw YAML data into a baseline.
+func FromYaml(data []byte) (Baseline, error) {
+	var b Baseline
+	err := yaml.Unmarshal(data, &b)
+	return b, err
+}
+
+// ToYaml serializes the baseline into YAML.
+func (b Baseline) ToYaml() ([]byte, error) {
+	return yaml.Marshal(b)
+}
+
+// Filter keeps track of which known issues were already encountered.
+type Filter struct {
+	remaining map[Entry]int
+}
+
+// NewFilter returns a filter for the entries of the baseline.
+func (b Baseline) NewFilter() *Filter {
+	f := &Filter{remaining: make(map[Entry]int)}
+	for _, entry := range b.Issues {
+		count := entry.Count
+		if count <= 0 {
+			count = 1
+		}
+		f.remaining[entry.key()] += count
+	}
+	return f
+}
+
+// Known returns true if the given entry is within the baseline.
+// Each recorded issue is only known once; If code contains more issues than recorded, the additional ones are unknown.
+func (f *Filter) Known(entry Entry) bool {
+	if f == nil {
+		return false
+	}
+	key := entry.key()
+	if f.remaining[key] <= 0 {
+		return false
+	}
+	f.remaining[key]--
+	return true
+}
@@ -0,0 +1,73 @@
+package baseline_test
+
+import (
+	"testing"
+
+	"github.com/dertseha/goconsider/pkg/baseline"
+	"github.com/dertseha/goconsider/pkg/consider"
+)
+
+func TestEntryForIsRelativeToBaseDir(t *testing.T) {
+	issue := consider.Issue{Context: "Type name", Found: "abcd", PhraseID: "abcd-phrase", Declaration: "AbcdType"}
+	entry := baseline.EntryFor("/base", "/base/some/dir/file.go", issue)
+	expected := baseline.Entry{
+		File: "some/dir/file.go", Declaration: "AbcdType", Context: "Type name", Found: "abcd", Phrase: "abcd-phrase", Count: 1,
+	}
+	if entry != expected {
+		t.Errorf("Unexpected entry %v, expected %v", entry, expected)
+	}
+}
+
+func TestNewMergesEntries(t *testing.T) {
+	entry := baseline.Entry{File: "file.go", Context: "Comment", Found: "abcd", Count: 1}
+	other := baseline.Entry{File: "file.go", Context: "Comment", Found: "efgh", Count: 1}
+	b := baseline.New([]baseline.Entry{other, entry, entry})
+	if len(b.Issues) != 2 {
+		t.Fatalf("Expected two entries, got %v", b.Issues)
+	}
+	if (b.Issues[0].Found != "abcd") || (b.Issues[0].Count != 2) {
+		t.Errorf("Unexpected first entry %v", b.Issues[0])
+	}
+}
+
+func TestFilterKnowsEachIssueOnlyCountTimes(t *testing.T) {
+	entry := baseline.Entry{File: "file.go", Context: "Comment", Found: "abcd", Count: 2}
+	filter := baseline.New([]baseline.Entry{entry}).NewFilter()
+	entry.Count = 1
+	for i := 0; i < 2; i++ {
+		if !filter.Known(entry) {
+			t.Errorf("Entry should be known at %d", i)
+		}
+	}
+	if filter.Known(entry) {
+		t.Errorf("Entry should not be known anymore")
+	}
+}
+
+func TestFilterDistinguishesPhrases(t *testing.T) {
+	entry := baseline.Entry{File: "file.go", Context: "Comment", Found: "abcd", Phrase: "abcd-phrase", Count: 1}
+	filter := baseline.New([]baseline.Entry{entry}).NewFilter()
+	entry.Phrase = "other-phrase"
+	if filter.Known(entry) {
+		t.Errorf("Entry of other phrase should not be known")
+	}
+	entry.Phrase = "abcd-phrase"
+	if !filter.Known(entry) {
+		t.Errorf("Entry should be known")
+	}
+}
+
+func TestYamlRoundTrip(t *testing.T) {
+	b := baseline.New([]baseline.Entry{{File: "file.go", Declaration: "Decl", Context: "Comment", Found: "abcd", Count: 3}})
+	data, err := b.ToYaml()
+	if err != nil {
+		t.Fatalf("Failed to serialize: %v", err)
+	}
+	parsed, err := baseline.FromYaml(data)
+	if err != nil {
+		t.Fatalf("Failed to parse: %v", err)
+	}
+	if (len(parsed.Issues) != 1) || (parsed.Issues[0] != b.Issues[0]) {
+		t.Errorf("Unexpected result %v", parsed.Issues)
+	}
+}
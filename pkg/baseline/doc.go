@@ -0,0 +1,5 @@
+// Package baseline handles records of known issues, which shall not be reported again.
+//
+// A baseline allows adopting the linter in existing code: all current issues are recorded once,
+// and only new issues are reported afterwards.
+package baseline
@@ -0,0 +1,72 @@
+package consider
+
+import (
+	"embed"
+	"errors"
+	"fmt"
+	"path"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// ErrUnknownLocale is returned for locales without a bundled catalog, if no custom catalog is given either.
+var ErrUnknownLocale = errors.New("unknown locale")
+
+//go:embed locales/*.yaml
+var bundledCatalogs embed.FS
+
+// catalog maps messages in their English form to their translation.
+type catalog map[string]string
+
+// catalogFor returns the catalog for given locale, such as "de" or "ja_JP.UTF-8", with the custom entries on top.
+// An empty locale is English. If there is no bundled catalog for the locale, its language is tried, such as "ja"
+// for "ja-JP".
+func catalogFor(locale string, custom map[string]string) (catalog, error) {
+	result := make(catalog)
+	bundled, found := bundledCatalog(locale)
+	if !found && (len(custom) == 0) {
+		return nil, fmt.Errorf("%w: '%s'", ErrUnknownLocale, locale)
+	}
+	for key, value := range bundled {
+		result[key] = value
+	}
+	for key, value := range custom {
+		result[key] = value
+	}
+	return result, nil
+}
+
+func bundledCatalog(locale string) (catalog, bool) {
+	normalized := strings.ToLower(strings.ReplaceAll(locale, "_", "-"))
+	normalized, _, _ = strings.Cut(normalized, ".")
+	if len(normalized) == 0 {
+		normalized = "en"
+	}
+	language, _, _ := strings.Cut(normalized, "-")
+	for _, name := range []string{normalized, language} {
+		data, err := bundledCatalogs.ReadFile(path.Join("locales", name+".yaml"))
+		if err != nil {
+			continue
+		}
+		var c catalog
+		if err := yaml.Unmarshal(data, &c); err != nil {
+			panic(fmt.Sprintf("failed to parse bundled catalog %s: %v", name, err))
+		}
+		return c, true
+	}
+	return nil, false
+}
+
+// translate returns the translation of given message, or the message itself if there is none.
+// If arguments are given, the translation is used as format string for them.
+func (c catalog) translate(message string, args ...interface{}) string {
+	translated, found := c[message]
+	if !found || (len(translated) == 0) {
+		translated = message
+	}
+	if len(args) == 0 {
+		return translated
+	}
+	return fmt.Sprintf(translated, args...)
+}
@@ -0,0 +1,76 @@
+package consider_test
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/dertseha/goconsider/pkg/consider"
+	"gopkg.in/yaml.v3"
+)
+
+func readCatalog(t *testing.T, filename string) map[string]string {
+	t.Helper()
+	data, err := os.ReadFile(filename)
+	if err != nil {
+		t.Fatalf("Failed to read catalog: %v", err)
+	}
+	var catalog map[string]string
+	if err := yaml.Unmarshal(data, &catalog); err != nil {
+		t.Fatalf("Failed to parse catalog %s: %v", filename, err)
+	}
+	return catalog
+}
+
+func TestBundledCatalogsTranslateAllMessages(t *testing.T) {
+	reference := readCatalog(t, filepath.Join("locales", "en.yaml"))
+	filenames, _ := filepath.Glob(filepath.Join("locales", "*.yaml"))
+	for _, filename := range filenames {
+		catalog := readCatalog(t, filename)
+		for message := range reference {
+			if len(catalog[message]) == 0 {
+				t.Errorf("Catalog %s has no translation for %q", filename, message)
+			}
+		}
+		for message := range catalog {
+			if _, known := reference[message]; !known {
+				t.Errorf("Catalog %s has unknown message %q", filename, message)
+			}
+		}
+	}
+}
+
+func TestMessagesAreTranslatedForLocale(t *testing.T) {
+	settings := abcdSettings("abcd")
+	settings.Formatting.Locale = "ja-JP"
+	_, issues := checkSource(t, settings, "package source\n\nvar abcd = 1\n")
+	if (len(issues) != 1) || !strings.HasPrefix(issues[0].Message, "値の名前に「abcd」が含まれています") {
+		t.Errorf("Unexpected issues %v", issues)
+	}
+	if issues[0].Context != "Value name" {
+		t.Errorf("Context of issue is translated: %s", issues[0].Context)
+	}
+}
+
+func TestCustomCatalogTakesPrecedence(t *testing.T) {
+	settings := abcdSettings("abcd")
+	settings.Formatting.Locale = "de"
+	settings.Formatting.Catalog = map[string]string{"Value name": "Variablenname"}
+	_, issues := checkSource(t, settings, "package source\n\nvar abcd = 1\n")
+	if (len(issues) != 1) || !strings.HasPrefix(issues[0].Message, "Variablenname enthält 'abcd'") {
+		t.Errorf("Unexpected issues %v", issues)
+	}
+}
+
+func TestFormattingValidateRejectsUnknownLocale(t *testing.T) {
+	err := consider.Formatting{Locale: "xx"}.Validate()
+	if !errors.Is(err, consider.ErrUnknownLocale) {
+		t.Errorf("Unexpected error %v", err)
+	}
+	err = consider.Formatting{Locale: "xx", Catalog: map[string]string{"Comment": "Xx"}}.Validate()
+	if err != nil {
+		t.Errorf("Unknown locale with custom catalog has error %v", err)
+	}
+}
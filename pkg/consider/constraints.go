@@ -0,0 +1,128 @@
+package consider
+
+import (
+	"fmt"
+	"go/ast"
+	"go/types"
+	"strings"
+)
+
+// nameConstraint explains why a name is dictated by external code and cannot be chosen freely.
+type nameConstraint struct {
+	message string
+	args    []interface{}
+}
+
+// String returns the explanation in English.
+func (c nameConstraint) String() string {
+	return fmt.Sprintf(c.message, c.args...)
+}
+
+// SetModulePath provides the path of the module of the checked files, such as "github.com/some/project".
+// Interfaces declared outside of this module dictate the names of the methods that implement them.
+// Without it, all packages other than the checked one are considered to be outside the module.
+func (l *Linter) SetModulePath(path string) {
+	l.modulePath = path
+}
+
+// constrainNames sets the function that determines the constraint of subsequently checked names.
+// The function is only called for names that contain a phrase.
+func (l *Linter) constrainNames(constraintOf func() *nameConstraint) func() {
+	current := l.constraintOf
+	l.constraintOf = constraintOf
+	return func() { l.constraintOf = current }
+}
+
+// funcNameConstraint returns the constraint of the name of a function, or nil if it can be chosen freely.
+// Names of functions that are exported to C, or referenced by a go:linkname directive, are constrained.
+// So are the names of methods that are required to implement an interface declared outside the module.
+func (l *Linter) funcNameConstraint(funcDecl *ast.FuncDecl) *nameConstraint {
+	name := funcDecl.Name.Name
+	if (funcDecl.Recv == nil) && hasCgoExport(funcDecl.Doc, name) {
+		return &nameConstraint{message: "The name is exported to C."}
+	}
+	if (funcDecl.Recv == nil) && hasLinkname(l.file.Comments, name) {
+		return &nameConstraint{message: "The name is referenced by a go:linkname directive."}
+	}
+	if (funcDecl.Recv == nil) || (l.typesInfo == nil) {
+		return nil
+	}
+	method, isFunc := l.typesInfo.Defs[funcDecl.Name].(*types.Func)
+	if !isFunc {
+		return nil
+	}
+	if iface := l.externalInterfaceRequiring(method); len(iface) != 0 {
+		return &nameConstraint{message: "The name is required to implement %[1]s.", args: []interface{}{iface}}
+	}
+	return nil
+}
+
+func hasCgoExport(doc *ast.CommentGroup, name string) bool {
+	if doc == nil {
+		return false
+	}
+	for _, comment := range doc.List {
+		if fields := strings.Fields(comment.Text); (len(fields) >= 2) && (fields[0] == "//export") && (fields[1] == name) {
+			return true
+		}
+	}
+	return false
+}
+
+func hasLinkname(groups []*ast.CommentGroup, name string) bool {
+	for _, group := range groups {
+		for _, comment := range group.List {
+			if fields := strings.Fields(comment.Text); (len(fields) >= 2) && (fields[0] == "//go:linkname") && (fields[1] == name) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// externalInterfaceRequiring returns the qualified name of an interface, declared outside the module,
+// that requires a method of the same name and that values of the receiver type are used as within the package.
+// Interfaces that the type merely happens to implement do not constrain the name.
+// An empty string is returned if there is no such interface.
+func (l *Linter) externalInterfaceRequiring(method *types.Func) string {
+	if !method.Exported() || (method.Pkg() == nil) {
+		return ""
+	}
+	recv := method.Type().(*types.Signature).Recv()
+	if recv == nil {
+		return ""
+	}
+	recvType := recv.Type()
+	if pointer, isPointer := recvType.(*types.Pointer); isPointer {
+		recvType = pointer.Elem()
+	}
+	pointerType := types.NewPointer(recvType)
+	for _, use := range l.interfaceUses() {
+		if !types.Identical(use.concrete, recvType) && !types.Identical(use.concrete, pointerType) {
+			continue
+		}
+		obj, _, _ := types.LookupFieldOrMethod(use.iface, false, method.Pkg(), method.Name())
+		required, isFunc := obj.(*types.Func)
+		if !isFunc || (required.Pkg() == nil) || (required.Pkg() == method.Pkg()) || !l.outsideModule(required.Pkg()) {
+			continue
+		}
+		return interfaceNameOf(required)
+	}
+	return ""
+}
+
+// interfaceNameOf returns the qualified name of the interface that declares given method.
+func interfaceNameOf(method *types.Func) string {
+	qualifier := func(pkg *types.Package) string { return pkg.Name() }
+	if recv := method.Type().(*types.Signature).Recv(); recv != nil {
+		return types.TypeString(recv.Type(), qualifier)
+	}
+	return method.Pkg().Name() + "." + method.Name()
+}
+
+func (l *Linter) outsideModule(pkg *types.Package) bool {
+	if len(l.modulePath) == 0 {
+		return true
+	}
+	return (pkg.Path() != l.modulePath) && !strings.HasPrefix(pkg.Path(), l.modulePath+"/")
+}
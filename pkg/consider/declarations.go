@@ -0,0 +1,81 @@
+package consider
+
+import (
+	"go/ast"
+	"go/token"
+	"strings"
+)
+
+// declarationNameAt returns the name of the top-level declaration that contains the given position.
+// The documentation of a declaration is considered to be part of it.
+// An empty string is returned for positions outside any declaration.
+func declarationNameAt(decls []ast.Decl, pos token.Pos) string {
+	for _, decl := range decls {
+		switch typedDecl := decl.(type) {
+		case *ast.FuncDecl:
+			if contains(typedDecl.Doc, typedDecl, pos) {
+				return funcDeclName(typedDecl)
+			}
+		case *ast.GenDecl:
+			if contains(typedDecl.Doc, typedDecl, pos) {
+				return specNameAt(typedDecl, pos)
+			}
+		}
+	}
+	return ""
+}
+
+func contains(doc *ast.CommentGroup, node ast.Node, pos token.Pos) bool {
+	start := node.Pos()
+	if doc != nil {
+		start = doc.Pos()
+	}
+	return (start <= pos) && (pos < node.End())
+}
+
+func funcDeclName(decl *ast.FuncDecl) string {
+	if (decl.Recv == nil) || (len(decl.Recv.List) == 0) {
+		return decl.Name.Name
+	}
+	return receiverTypeName(decl.Recv.List[0].Type) + "." + decl.Name.Name
+}
+
+func receiverTypeName(expr ast.Expr) string {
+	switch typedExpr := expr.(type) {
+	case *ast.Ident:
+		return typedExpr.Name
+	case *ast.StarExpr:
+		return receiverTypeName(typedExpr.X)
+	case *ast.ParenExpr:
+		return receiverTypeName(typedExpr.X)
+	case *ast.IndexExpr:
+		return receiverTypeName(typedExpr.X)
+	case *ast.IndexListExpr:
+		return receiverTypeName(typedExpr.X)
+	}
+	return ""
+}
+
+func specNameAt(decl *ast.GenDecl, pos token.Pos) string {
+	for _, spec := range decl.Specs {
+		switch typedSpec := spec.(type) {
+		case *ast.TypeSpec:
+			if contains(typedSpec.Doc, typedSpec, pos) || (len(decl.Specs) == 1) {
+				return typedSpec.Name.Name
+			}
+		case *ast.ValueSpec:
+			if contains(typedSpec.Doc, typedSpec, pos) || (len(decl.Specs) == 1) {
+				return valueSpecName(typedSpec)
+			}
+		}
+	}
+	return ""
+}
+
+func valueSpecName(spec *ast.ValueSpec) string {
+	names := make([]string, 0, len(spec.Names))
+	for _, name := range spec.Names {
+		names = append(names, name.Name)
+	}
+	return strings.Join(names, ",")
+}
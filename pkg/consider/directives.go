@@ -0,0 +1,174 @@
+package consider
+
+import (
+	"go/ast"
+	"go/token"
+	"strings"
+)
+
+const (
+	directivePrefix = "//goconsider:"
+
+	directiveIgnore     = "ignore"
+	directiveFileIgnore = "file-ignore"
+)
+
+// directive is a comment that suppresses issues.
+//
+// The following forms are supported:
+//
+//	//goconsider:ignore                 ignores all phrases in the line, or the following declaration or statement.
+//	//goconsider:ignore phrase1, phrase2 ignores only the listed phrases, by synonym or identifier.
+//	//goconsider:file-ignore            ignores all phrases in the whole file.
+//	//goconsider:file-ignore phrase1    ignores only the listed phrases in the whole file.
+//
+// Any text following a further "//" is considered an explanation and is not evaluated.
+type directive struct {
+	comment *ast.Comment
+	// text is the directive without explanation.
+	text string
+	// phrases lists the phrases the directive applies to. All phrases are affected if empty.
+	phrases []string
+	// wholeFile is set for directives that apply to all lines of the file.
+	wholeFile bool
+	// fromLine and toLine specify the range of lines the directive applies to, inclusive.
+	fromLine int
+	toLine   int
+	// used is set once the directive suppressed an issue.
+	used bool
+}
+
+func isDirective(comment *ast.Comment) bool {
+	return strings.HasPrefix(comment.Text, directivePrefix)
+}
+
+// isCommentDirective returns true for any kind of directive comment, such as "//go:generate",
+// "//export", "//line", or "//goconsider:ignore".
+// The detection follows the rules of ast.CommentGroup.Text, which also excludes these.
+func isCommentDirective(comment *ast.Comment) bool {
+	if !strings.HasPrefix(comment.Text, "//") {
+		return false
+	}
+	c := comment.Text[2:]
+	if strings.HasPrefix(c, "line ") || strings.HasPrefix(c, "extern ") || strings.HasPrefix(c, "export ") {
+		return true
+	}
+	colon := strings.Index(c, ":")
+	if (colon <= 0) || (colon+1 >= len(c)) {
+		return false
+	}
+	for i := 0; i <= colon+1; i++ {
+		if i == colon {
+			continue
+		}
+		b := c[i]
+		if !(('a' <= b) && (b <= 'z')) && !(('0' <= b) && (b <= '9')) {
+			return false
+		}
+	}
+	return true
+}
+
+func parseDirective(comment *ast.Comment) (*directive, bool) {
+	if !isDirective(comment) {
+		return nil, false
+	}
+	content := strings.TrimPrefix(comment.Text, directivePrefix)
+	if explanationStart := strings.Index(content, "//"); explanationStart >= 0 {
+		content = content[:explanationStart]
+	}
+	content = strings.TrimSpace(content)
+	name, args, _ := strings.Cut(content, " ")
+	d := &directive{comment: comment, text: directivePrefix + content}
+	switch name {
+	case directiveIgnore:
+	case directiveFileIgnore:
+		d.wholeFile = true
+	default:
+		return nil, false
+	}
+	for _, arg := range strings.Split(args, ",") {
+		if phrase := strings.ToLower(strings.TrimSpace(arg)); len(phrase) > 0 {
+			d.phrases = append(d.phrases, phrase)
+		}
+	}
+	return d, true
+}
+
+func (d *directive) appliesTo(line int, phrase Phrase) bool {
+	if !d.wholeFile && ((line < d.fromLine) || (line > d.toLine)) {
+		return false
+	}
+	if len(d.phrases) == 0 {
+		return true
+	}
+	for _, name := range d.phrases {
+		if containsString(phrase.Synonyms, name) || strings.EqualFold(phrase.Identifier(), name) {
+			return true
+		}
+	}
+	return false
+}
+
+// collectDirectives returns all directives of a file.
+// A directive on its own line applies to the declaration or statement that directly follows
+// its comment group. Otherwise, it only applies to its own line.
+func collectDirectives(file *ast.File, rawFile *token.File) []*directive {
+	var directives []*directive
+	var nodes *lineNodes
+	for _, group := range file.Comments {
+		for _, comment := range group.List {
+			d, ok := parseDirective(comment)
+			if !ok {
+				continue
+			}
+			if !d.wholeFile {
+				if rawFile == nil {
+					continue
+				}
+				if nodes == nil {
+					nodes = lineNodesOf(file, rawFile)
+				}
+				d.fromLine, d.toLine = nodes.rangeFor(group, comment)
+			}
+			directives = append(directives, d)
+		}
+	}
+	return directives
+}
+
+// lineNodes keeps track of the outermost nodes that start in each line.
+type lineNodes struct {
+	rawFile *token.File
+	starts  map[int]ast.Node
+}
+
+func lineNodesOf(file *ast.File, rawFile *token.File) *lineNodes {
+	nodes := &lineNodes{rawFile: rawFile, starts: make(map[int]ast.Node)}
+	ast.Inspect(file, func(node ast.Node) bool {
+		switch node.(type) {
+		case nil, *ast.File:
+			return true
+		case *ast.CommentGroup, *ast.Comment:
+			return false
+		}
+		line := rawFile.Line(node.Pos())
+		if existing, known := nodes.starts[line]; !known || (node.Pos() < existing.Pos()) {
+			nodes.starts[line] = node
+		}
+		return true
+	})
+	return nodes
+}
+
+func (nodes *lineNodes) rangeFor(group *ast.CommentGroup, comment *ast.Comment) (from int, to int) {
+	line := nodes.rawFile.Line(comment.Slash)
+	if existing, known := nodes.starts[line]; known && (existing.Pos() < comment.Slash) {
+		return line, line
+	}
+	next, known := nodes.starts[nodes.rawFile.Line(group.End())+1]
+	if !known {
+		return line, line
+	}
+	return nodes.rawFile.Line(group.Pos()), nodes.rawFile.Line(next.End())
+}
@@ -3,53 +3,118 @@ package consider
 import (
 	"bytes"
 	_ "embed"
+	"errors"
 	"fmt"
 	"strings"
 	"text/template"
 )
 
-type referenceModel struct {
-	// Short refers to the key that is directly associated with the finding.
-	Short string
-	// Long is a resolved string identified by the short string. Empty if not found.
-	Long string
-}
+// ErrInvalidTemplate is returned for message templates that cannot be used.
+var ErrInvalidTemplate = errors.New("invalid message template")
 
 type formatModel struct {
-	// Context is describing where the triggering phrase was found.
+	// Context is describing where the triggering phrase was found, translated for the locale.
 	Context string
 	// Found is the triggering phrase.
 	Found string
+	// Original is the text as it was found in the source, such as "FooBar" for the phrase "foo bar".
+	Original string
+	// PhraseID is the identifier of the phrase, see Phrase.Identifier.
+	PhraseID string
 	// Alternatives is the list of possibilities that can replace the phrase.
 	Alternatives []string
 	// References is the list of sources for the reasoning.
-	References []referenceModel
+	References []Reference
+	// Filename is the name of the file, without directories.
+	Filename string
+	// Declaration is the name of the top-level declaration that contains the phrase. Empty if outside any.
+	Declaration string
+	// Constraint explains why the name is dictated by external code, translated for the locale.
+	// Empty for names that can be chosen freely.
+	Constraint string
 
 	// PrintReferences is true if the long form shall be added to the message.
 	PrintReferences bool
 }
 
 type formatter struct {
-	templ *template.Template
+	templ   *template.Template
+	catalog catalog
+	err     error
 }
 
 //go:embed default_format.gotext
 var defaultFormat string
 
-func newFormatter() *formatter {
-	funcs := template.FuncMap{"join": strings.Join}
-	templ, err := template.New("message").Funcs(funcs).Parse(defaultFormat)
+// newFormatter returns a formatter for given formatting settings.
+// The built-in format is used if no template is set.
+func newFormatter(formatting Formatting) (*formatter, error) {
+	c, err := catalogFor(formatting.Locale, formatting.Catalog)
+	if err != nil {
+		return nil, err
+	}
+	source := formatting.Template
+	if len(source) == 0 {
+		source = defaultFormat
+	}
+	funcs := template.FuncMap{"join": strings.Join, "tr": c.translate}
+	templ, err := template.New("message").Funcs(funcs).Parse(source)
 	if err != nil {
-		panic(fmt.Sprintf("failed to parse built-in format: %v", err))
+		return nil, fmt.Errorf("%w: %v", ErrInvalidTemplate, err)
 	}
-	return &formatter{templ: templ}
+	return &formatter{templ: templ, catalog: c}, nil
+}
+
+// brokenFormatter returns a formatter that reports the given error in all its messages.
+func brokenFormatter(err error) *formatter {
+	return &formatter{err: err}
+}
+
+// Translate returns the translation of given message, see catalog.translate.
+func (f *formatter) Translate(message string, args ...interface{}) string {
+	return f.catalog.translate(message, args...)
 }
 
 func (f *formatter) Format(model formatModel) string {
+	text, err := f.format(model)
+	if err != nil {
+		return fmt.Sprintf("failed to format message: %v", err)
+	}
+	return text
+}
+
+func (f *formatter) format(model formatModel) (string, error) {
+	if f.err != nil {
+		return "", f.err
+	}
 	buf := bytes.NewBuffer(nil)
 	err := f.templ.Execute(buf, model)
 	if err != nil {
-		return fmt.Sprintf("failed to format message: %v", err)
+		return "", err
+	}
+	return buf.String(), nil
+}
+
+// Validate returns an error if the template of the formatting cannot be parsed,
+// if it refers to unknown fields or functions, or if the locale is unknown.
+func (formatting Formatting) Validate() error {
+	f, err := newFormatter(formatting)
+	if err != nil {
+		return err
+	}
+	_, err = f.format(formatModel{
+		Context:         "Type name",
+		Found:           "foo bar",
+		Original:        "FooBar",
+		PhraseID:        "foo-bar",
+		Alternatives:    []string{"baz"},
+		References:      []Reference{{Short: "ref", Long: "https://example.com"}},
+		Filename:        "file.go",
+		Declaration:     "FooBar",
+		PrintReferences: true,
+	})
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidTemplate, err)
 	}
-	return buf.String()
+	return nil
 }
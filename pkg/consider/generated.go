@@ -0,0 +1,34 @@
+package consider
+
+import (
+	"go/ast"
+	"strings"
+)
+
+// isGenerated reports whether the file was generated by a program, not handwritten.
+// It follows the convention of https://go.dev/s/generatedcode, and is equivalent to ast.IsGenerated:
+// A line comment of the form "// Code generated ... DO NOT EDIT." must appear before the package clause.
+func isGenerated(file *ast.File) bool {
+	for _, group := range file.Comments {
+		if group.Pos() > file.Package {
+			return false
+		}
+		for _, comment := range group.List {
+			if isGeneratedComment(comment.Text) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func isGeneratedComment(text string) bool {
+	if !strings.HasPrefix(text, "//") {
+		return false
+	}
+	const prefix = " Code generated "
+	const suffix = " DO NOT EDIT."
+	line := strings.TrimPrefix(text, "//")
+	line = strings.TrimSuffix(line, "\r")
+	return (len(line) >= len(prefix)+len(suffix)) && strings.HasPrefix(line, prefix) && strings.HasSuffix(line, suffix)
+}
@@ -0,0 +1,187 @@
+package consider
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+)
+
+// interfaceUse is a value of a concrete type that is used as a value of an interface type,
+// for example by passing it to a function, assigning it, or returning it.
+type interfaceUse struct {
+	concrete types.Type
+	iface    *types.Interface
+}
+
+// SetPackageFiles provides all files of the package of the checked files.
+// They are searched for the interfaces that values of a type are used as, which dictate the names of its methods.
+// Without them, only the checked file is searched.
+func (l *Linter) SetPackageFiles(files []*ast.File) {
+	l.packageFiles = files
+}
+
+// interfaceUses returns the uses of concrete types as interfaces within the files of the package.
+// They are collected once, when first needed.
+func (l *Linter) interfaceUses() []interfaceUse {
+	if l.interfaceUsesCollected {
+		return l.collectedInterfaceUses
+	}
+	files := l.packageFiles
+	if len(files) == 0 {
+		files = []*ast.File{l.file}
+	}
+	l.collectedInterfaceUses = interfaceUsesIn(files, l.typesInfo)
+	l.interfaceUsesCollected = true
+	return l.collectedInterfaceUses
+}
+
+// interfaceUsesIn returns all places within the files where a value of a concrete type is implicitly or explicitly
+// converted to an interface type. These are arguments of calls, conversions, assignments, variable declarations,
+// return values, elements of composite literals, and sent values.
+func interfaceUsesIn(files []*ast.File, info *types.Info) []interfaceUse {
+	var uses []interfaceUse
+	use := func(expr ast.Expr, target types.Type) {
+		if (expr == nil) || (target == nil) {
+			return
+		}
+		iface, isInterface := target.Underlying().(*types.Interface)
+		concrete := info.TypeOf(expr)
+		if !isInterface || (concrete == nil) || types.IsInterface(concrete) {
+			return
+		}
+		uses = append(uses, interfaceUse{concrete: concrete, iface: iface})
+	}
+	for _, file := range files {
+		var stack []ast.Node
+		ast.Inspect(file, func(node ast.Node) bool {
+			if node == nil {
+				stack = stack[:len(stack)-1]
+				return true
+			}
+			stack = append(stack, node)
+			switch n := node.(type) {
+			case *ast.CallExpr:
+				callUses(info, n, use)
+			case *ast.AssignStmt:
+				if (n.Tok == token.ASSIGN) && (len(n.Lhs) == len(n.Rhs)) {
+					for index, lhs := range n.Lhs {
+						use(n.Rhs[index], info.TypeOf(lhs))
+					}
+				}
+			case *ast.ValueSpec:
+				if (n.Type != nil) && (len(n.Names) == len(n.Values)) {
+					for _, value := range n.Values {
+						use(value, info.TypeOf(n.Type))
+					}
+				}
+			case *ast.ReturnStmt:
+				results := enclosingResults(info, stack)
+				if (results != nil) && (results.Len() == len(n.Results)) {
+					for index, result := range n.Results {
+						use(result, results.At(index).Type())
+					}
+				}
+			case *ast.CompositeLit:
+				compositeLitUses(info, n, use)
+			case *ast.SendStmt:
+				if ch, isChan := underlyingOf(info.TypeOf(n.Chan)).(*types.Chan); isChan {
+					use(n.Value, ch.Elem())
+				}
+			}
+			return true
+		})
+	}
+	return uses
+}
+
+func callUses(info *types.Info, call *ast.CallExpr, use func(ast.Expr, types.Type)) {
+	fun, known := info.Types[call.Fun]
+	if !known {
+		return
+	}
+	if fun.IsType() {
+		if len(call.Args) == 1 {
+			use(call.Args[0], fun.Type)
+		}
+		return
+	}
+	sig, isSignature := underlyingOf(fun.Type).(*types.Signature)
+	if !isSignature {
+		return
+	}
+	params := sig.Params()
+	for index, arg := range call.Args {
+		switch {
+		case sig.Variadic() && (index >= params.Len()-1):
+			if slice, isSlice := params.At(params.Len() - 1).Type().(*types.Slice); isSlice && !call.Ellipsis.IsValid() {
+				use(arg, slice.Elem())
+			}
+		case index < params.Len():
+			use(arg, params.At(index).Type())
+		}
+	}
+}
+
+func compositeLitUses(info *types.Info, lit *ast.CompositeLit, use func(ast.Expr, types.Type)) {
+	switch t := underlyingOf(info.TypeOf(lit)).(type) {
+	case *types.Struct:
+		for index, elt := range lit.Elts {
+			if kv, isKeyValue := elt.(*ast.KeyValueExpr); isKeyValue {
+				if key, isIdent := kv.Key.(*ast.Ident); isIdent {
+					use(kv.Value, info.TypeOf(key))
+				}
+			} else if index < t.NumFields() {
+				use(elt, t.Field(index).Type())
+			}
+		}
+	case *types.Slice:
+		elementUses(lit.Elts, t.Elem(), use)
+	case *types.Array:
+		elementUses(lit.Elts, t.Elem(), use)
+	case *types.Map:
+		for _, elt := range lit.Elts {
+			if kv, isKeyValue := elt.(*ast.KeyValueExpr); isKeyValue {
+				use(kv.Key, t.Key())
+				use(kv.Value, t.Elem())
+			}
+		}
+	}
+}
+
+func elementUses(elts []ast.Expr, elem types.Type, use func(ast.Expr, types.Type)) {
+	for _, elt := range elts {
+		if kv, isKeyValue := elt.(*ast.KeyValueExpr); isKeyValue {
+			elt = kv.Value
+		}
+		use(elt, elem)
+	}
+}
+
+// enclosingResults returns the results of the innermost function of given stack of nodes.
+func enclosingResults(info *types.Info, stack []ast.Node) *types.Tuple {
+	for index := len(stack) - 1; index >= 0; index-- {
+		var sig types.Type
+		switch n := stack[index].(type) {
+		case *ast.FuncLit:
+			sig = info.TypeOf(n)
+		case *ast.FuncDecl:
+			if obj := info.Defs[n.Name]; obj != nil {
+				sig = obj.Type()
+			}
+		default:
+			continue
+		}
+		if signature, isSignature := sig.(*types.Signature); isSignature {
+			return signature.Results()
+		}
+		return nil
+	}
+	return nil
+}
+
+func underlyingOf(t types.Type) types.Type {
+	if t == nil {
+		return nil
+	}
+	return t.Underlying()
+}
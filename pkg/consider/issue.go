@@ -0,0 +1,60 @@
+package consider
+
+import (
+	"go/ast"
+	"go/token"
+)
+
+// Issue describes a single finding of the linter.
+type Issue struct {
+	// Pos is the position of the first character of the found phrase.
+	Pos token.Pos
+	// End is the position just after the last character of the found phrase.
+	End token.Pos
+	// Message is the human-readable description of the finding.
+	Message string
+
+	// Context describes where the phrase was found, such as "Type name" or "Comment".
+	Context string
+	// Found is the synonym of the phrase that was found.
+	Found string
+	// PhraseIndex is the index of the phrase within the settings. It is -1 for issues that are not about a phrase,
+	// such as unused directives.
+	PhraseIndex int
+	// PhraseID is the identifier of the phrase, see Phrase.Identifier.
+	PhraseID string
+	// Severity is the severity of the finding. It is that of the phrase, unless the settings specify a different
+	// severity for the kind of finding, such as for struct tags.
+	Severity string
+	// PhraseSeverity is the severity of the phrase, see Settings.SeverityOf.
+	// It is empty for issues that are not about a phrase.
+	PhraseSeverity string
+	// Synonyms are all the synonyms of the phrase.
+	Synonyms []string
+	// Alternatives are the proposed replacements of the phrase.
+	Alternatives []string
+	// References are the references of the phrase, resolved from the settings.
+	References []Reference
+	// Declaration is the name of the top-level declaration that contains the finding.
+	// Methods are named with their receiver type, such as "Type.Method".
+	// It is empty for findings outside any declaration.
+	Declaration string
+	// Constraint explains why the name containing the finding is dictated by external code,
+	// such as an interface it implements. It is empty for names that can be chosen freely.
+	Constraint string
+
+	// Ident is the declared identifier that contains the finding.
+	// It is nil if the finding is not within an identifier that can be renamed.
+	Ident *ast.Ident
+	// Renames are the proposed new names for Ident, one for each alternative of the phrase
+	// that results in a valid identifier. There are none for names with a Constraint.
+	Renames []string
+}
+
+// Reference is a reference of a phrase, resolved from the settings.
+type Reference struct {
+	// Short refers to the key that is directly associated with the finding.
+	Short string `json:"short"`
+	// Long is a resolved string identified by the short string. Empty if not found.
+	Long string `json:"long,omitempty"`
+}
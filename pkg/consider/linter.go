@@ -3,7 +3,9 @@ package consider
 import (
 	"go/ast"
 	"go/token"
+	"go/types"
 	"path/filepath"
+	"sort"
 	"strings"
 
 	"github.com/dertseha/goconsider/internal/text"
@@ -12,36 +14,89 @@ import (
 // Reporter is the outgoing interface for detected issues.
 type Reporter interface {
 	// Report is called for each detected issue.
-	Report(pos token.Pos, message string)
+	Report(issue Issue)
 }
 
 // Linter is the main type of the linting functionality.
 type Linter struct {
-	settings  Settings
-	formatter *formatter
-	reporter  Reporter
-
+	settings   Settings
+	formatter  *formatter
+	reporter   Reporter
+	typesInfo  *types.Info
+	modulePath string
+	rootDir    string
+
+	packageFiles           []*ast.File
+	collectedInterfaceUses []interfaceUse
+	interfaceUsesCollected bool
+
+	file             *ast.File
+	rawFile          *token.File
+	phraseIndices    []int
+	directives       []*directive
 	issuesSuppressed bool
+	severityOverride string
+	constraintOf     func() *nameConstraint
 }
 
 // NewLinter returns a new instance for given parameters.
+// If the message template of the settings is invalid, the messages of the issues describe the error.
+// Use Formatting.Validate to detect this beforehand.
 func NewLinter(settings Settings, reporter Reporter) *Linter {
+	f, err := newFormatter(settings.Formatting)
+	if err != nil {
+		f = brokenFormatter(err)
+	}
 	return &Linter{
 		settings:         settings,
-		formatter:        newFormatter(),
+		formatter:        f,
 		reporter:         reporter,
 		issuesSuppressed: false,
 	}
 }
 
 // CheckFile runs the analysis on given file.
+// Issues are not reported if they are suppressed by directives within the file.
+// Files, and phrases, are skipped based on the include and exclude patterns of the settings.
+// Phrases are also skipped based on the enabled and disabled tags, and the path rules.
+// Generated files are skipped, unless the settings specify otherwise.
 func (l *Linter) CheckFile(file *ast.File, rawFile *token.File) {
+	filename := ""
+	if rawFile != nil {
+		filename = l.relativeFilename(rawFile.Name())
+	}
+	if !fileSelected(l.settings.Include, l.settings.Exclude, filename) {
+		return
+	}
+	l.file = file
+	l.rawFile = rawFile
+	l.phraseIndices = nil
+	for index, phrase := range l.settings.Phrases {
+		if fileSelected(phrase.Include, phrase.Exclude, filename) &&
+			tagsSelected(l.settings.EnableTags, l.settings.DisableTags, phrase.Tags) &&
+			!disabledByPathRules(l.settings.PathRules, filename, phrase) {
+			l.phraseIndices = append(l.phraseIndices, index)
+		}
+	}
+	l.directives = collectDirectives(file, rawFile)
 	l.issuesSuppressed = false
+	if len(l.packageFiles) == 0 {
+		l.interfaceUsesCollected = false
+	}
+
+	if l.settings.SkipsGenerated() && isGenerated(file) {
+		if l.settings.ChecksGeneratedComments() {
+			l.checkCommentGroups(file.Comments)
+			l.checkDirectives(true)
+		}
+		return
+	}
 
 	l.checkFilename(file, rawFile)
-	l.checkIdent(file.Name, "Package name")
+	l.checkGeneric(file.Name.Name, "Package name", file.Name.NamePos)
 	l.checkCommentGroups(file.Comments)
 	l.checkDecls(file.Decls)
+	l.checkDirectives(false)
 }
 
 func (l *Linter) suppressIssues(on bool) func() {
@@ -50,22 +105,178 @@ func (l *Linter) suppressIssues(on bool) func() {
 	return func() { l.issuesSuppressed = currentSuppression }
 }
 
-func (l *Linter) addIssue(typeString string, pos token.Pos, synonym string, phrase Phrase) {
+// overrideSeverity sets the severity of subsequent issues, unless the given severity is empty.
+func (l *Linter) overrideSeverity(severity string) func() {
+	currentOverride := l.severityOverride
+	if len(severity) != 0 {
+		l.severityOverride = severity
+	}
+	return func() { l.severityOverride = currentOverride }
+}
+
+func (l *Linter) addIssue(typeString string, pos, end token.Pos, ident *ast.Ident, found occurrence) {
+	phrase := l.settings.Phrases[found.phraseIndex]
 	if l.issuesSuppressed {
 		return
 	}
-	l.reporter.Report(pos, l.formatMessage(typeString, synonym, phrase))
+	var constraint *nameConstraint
+	if l.constraintOf != nil {
+		constraint = l.constraintOf()
+	}
+	if ((constraint != nil) && !l.settings.ExternalNames.Reports()) || l.suppressedByDirective(pos, phrase) {
+		return
+	}
+	synonym := found.synonym
+	phraseSeverity := l.settings.SeverityOf(phrase)
+	severity := phraseSeverity
+	if len(l.severityOverride) != 0 {
+		severity = l.severityOverride
+	}
+	if constraint != nil {
+		severity = l.settings.ExternalNames.Severity
+	}
+	issue := Issue{
+		Pos:            pos,
+		End:            end,
+		Context:        typeString,
+		Found:          synonym,
+		PhraseIndex:    found.phraseIndex,
+		PhraseID:       phrase.Identifier(),
+		Severity:       severity,
+		PhraseSeverity: phraseSeverity,
+		Synonyms:       phrase.Synonyms,
+		Alternatives:   phrase.Alternatives,
+		References:     l.resolveReferences(phrase),
+		Declaration:    declarationNameAt(l.file.Decls, pos),
+		Ident:          ident,
+	}
+	if constraint != nil {
+		issue.Constraint = constraint.String()
+	}
+	issue.Message = l.formatMessage(issue, found.original, constraint)
+	if (ident != nil) && (constraint == nil) {
+		issue.Renames = renamesFor(ident.Name, synonym, phrase.Alternatives)
+	}
+	l.reporter.Report(issue)
+}
+
+func (l *Linter) suppressedByDirective(pos token.Pos, phrase Phrase) bool {
+	line := 0
+	if l.rawFile != nil {
+		line = l.rawFile.Line(pos)
+	}
+	suppressed := false
+	for _, d := range l.directives {
+		if d.appliesTo(line, phrase) {
+			d.used = true
+			suppressed = true
+		}
+	}
+	return suppressed
+}
+
+// checkDirectives reports the directives that did not suppress any issue, if the settings request this.
+// If only the comments of the file were checked, such as for generated files, only directives for the whole file
+// are considered. The others typically apply to names, which were not checked.
+func (l *Linter) checkDirectives(commentsOnly bool) {
+	if !l.settings.Directives.ReportsUnused() {
+		return
+	}
+	for _, d := range l.directives {
+		if !d.used && (d.wholeFile || !commentsOnly) {
+			l.reporter.Report(Issue{
+				Pos:         d.comment.Slash,
+				End:         d.comment.End(),
+				Message:     l.formatter.Translate("Unused goconsider directive '%[1]s'.", d.text),
+				Context:     "Directive",
+				PhraseIndex: -1,
+				Severity:    l.settings.DefaultSeverity(),
+				Declaration: declarationNameAt(l.file.Decls, d.comment.Slash),
+			})
+		}
+	}
 }
 
 func (l *Linter) checkGeneric(s string, typeString string, pos token.Pos) {
-	worded := text.Wordify(s)
-	for _, phrase := range l.settings.Phrases {
-		for _, synonym := range phrase.Synonyms {
-			if strings.Contains(worded, " "+synonym+" ") {
-				l.addIssue(typeString, pos, synonym, phrase)
+	l.checkText(s, typeString, nil, locatedAt(pos))
+}
+
+// checkText looks for all phrases within the given text.
+// The locate function maps the location of a match within the text to positions within the file.
+func (l *Linter) checkText(s string, typeString string, ident *ast.Ident, locate func(match text.Match) (token.Pos, token.Pos)) {
+	for _, found := range l.occurrencesIn(s) {
+		pos, end := locate(found.match)
+		l.addIssue(typeString, pos, end, ident, found)
+	}
+}
+
+// locatedAt returns a locate function for texts that are located at given position in the file.
+func locatedAt(pos token.Pos) func(match text.Match) (token.Pos, token.Pos) {
+	return func(match text.Match) (token.Pos, token.Pos) {
+		return pos + token.Pos(match.Start), pos + token.Pos(match.End)
+	}
+}
+
+type occurrence struct {
+	match       text.Match
+	original    string
+	synonym     string
+	phraseIndex int
+}
+
+// occurrencesIn returns the occurrences of all phrases, ordered by their location.
+// Occurrences do not overlap: Where several synonyms match at the same location, the longest one is taken.
+// Unless all occurrences are requested, only the first occurrence of each synonym is returned.
+func (l *Linter) occurrencesIn(s string) []occurrence {
+	words := text.Words(s)
+	var candidates []occurrence
+	for _, phraseIndex := range l.phraseIndices {
+		for _, synonym := range l.settings.Phrases[phraseIndex].Synonyms {
+			for _, match := range text.FindAll(words, synonym) {
+				candidates = append(candidates, occurrence{
+					match:       match,
+					original:    s[match.Start:match.End],
+					synonym:     synonym,
+					phraseIndex: phraseIndex,
+				})
 			}
 		}
 	}
+	sort.SliceStable(candidates, func(a, b int) bool {
+		if candidates[a].match.Start != candidates[b].match.Start {
+			return candidates[a].match.Start < candidates[b].match.Start
+		}
+		return candidates[a].match.End > candidates[b].match.End
+	})
+
+	all := l.settings.Occurrences == OccurrencesAll
+	var occurrences []occurrence
+	reported := make(map[string]bool)
+	lastEnd := -1
+	for _, candidate := range candidates {
+		if candidate.match.Start < lastEnd {
+			continue
+		}
+		lastEnd = candidate.match.End
+		if !all && reported[candidate.synonym] {
+			continue
+		}
+		reported[candidate.synonym] = true
+		occurrences = append(occurrences, candidate)
+	}
+	return occurrences
+}
+
+func renamesFor(name string, synonym string, alternatives []string) []string {
+	var renames []string
+	for _, alternative := range alternatives {
+		rename, found := text.Rephrase(name, synonym, alternative)
+		if !found || !token.IsIdentifier(rename) || (rename == name) || containsString(renames, rename) {
+			continue
+		}
+		renames = append(renames, rename)
+	}
+	return renames
 }
 
 func (l *Linter) checkIdents(idents []*ast.Ident, prefix string) {
@@ -78,7 +289,7 @@ func (l *Linter) checkIdent(ident *ast.Ident, typeString string) {
 	if ident == nil {
 		return
 	}
-	l.checkGeneric(ident.Name, typeString, ident.NamePos)
+	l.checkText(ident.Name, typeString, ident, locatedAt(ident.NamePos))
 }
 
 func (l *Linter) checkFilename(file *ast.File, rawFile *token.File) {
@@ -86,7 +297,10 @@ func (l *Linter) checkFilename(file *ast.File, rawFile *token.File) {
 		return
 	}
 	_, filename := filepath.Split(rawFile.Name())
-	l.checkGeneric(filename, "File name", file.Package)
+	// The file name is not part of the source. The issue is located at the package clause instead.
+	l.checkText(filename, "File name", nil, func(text.Match) (token.Pos, token.Pos) {
+		return file.Package, file.Name.End()
+	})
 }
 
 func (l *Linter) checkCommentGroups(groups []*ast.CommentGroup) {
@@ -95,8 +309,46 @@ func (l *Linter) checkCommentGroups(groups []*ast.CommentGroup) {
 	}
 }
 
+// checkCommentGroup checks the comments of a group as one text, so that phrases can span several lines.
+// Directives, such as "//go:generate" or "//goconsider:ignore", are not part of the text.
 func (l *Linter) checkCommentGroup(group *ast.CommentGroup) {
-	l.checkGeneric(group.Text(), "Comment", group.Pos())
+	var builder strings.Builder
+	var segments []commentSegment
+	for _, comment := range group.List {
+		if isCommentDirective(comment) {
+			continue
+		}
+		if builder.Len() > 0 {
+			builder.WriteString("\n")
+		}
+		content, pos := commentContent(comment)
+		segments = append(segments, commentSegment{offset: builder.Len(), pos: pos})
+		builder.WriteString(content)
+	}
+	l.checkText(builder.String(), "Comment", nil, func(match text.Match) (token.Pos, token.Pos) {
+		return posInSegments(segments, match.Start), posInSegments(segments, match.End-1) + 1
+	})
+}
+
+// commentSegment describes where the content of one comment starts, both in the text of the group and in the file.
+type commentSegment struct {
+	offset int
+	pos    token.Pos
+}
+
+// posInSegments returns the position in the file of the character at given offset in the text of the group.
+func posInSegments(segments []commentSegment, offset int) token.Pos {
+	index := sort.Search(len(segments), func(i int) bool { return segments[i].offset > offset }) - 1
+	return segments[index].pos + token.Pos(offset-segments[index].offset)
+}
+
+// commentContent returns the text of a comment without comment markers, and the position the text starts at.
+func commentContent(comment *ast.Comment) (string, token.Pos) {
+	content := comment.Text[2:]
+	if comment.Text[1] == '*' {
+		content = strings.TrimSuffix(content, "*/")
+	}
+	return content, comment.Slash + 2
 }
 
 func (l *Linter) checkDecls(decls []ast.Decl) {
@@ -149,6 +401,8 @@ func (l *Linter) checkImportSpec(spec *ast.ImportSpec) {
 
 func (l *Linter) checkValueSpec(spec *ast.ValueSpec) {
 	l.checkIdents(spec.Names, "Value name")
+	l.checkTypeExpr(spec.Type)
+	l.checkUses(spec.Values...)
 }
 
 func (l *Linter) checkType(spec *ast.TypeSpec) {
@@ -157,10 +411,14 @@ func (l *Linter) checkType(spec *ast.TypeSpec) {
 	l.checkTypeExpr(spec.Type)
 }
 
+// checkTypeExpr checks the names declared by type literals, such as the members of an anonymous struct.
+// The literals may be nested in other types, as in "[]struct{...}". Names of referenced types are not checked.
 func (l *Linter) checkTypeExpr(typeExpr ast.Expr) {
 	if typeExpr == nil {
 		return
 	}
+	reset := l.suppressIssues(false)
+	defer reset()
 	switch spec := typeExpr.(type) {
 	case *ast.StructType:
 		l.checkFieldList(spec.Fields, "Member name")
@@ -168,6 +426,25 @@ func (l *Linter) checkTypeExpr(typeExpr ast.Expr) {
 		l.checkFuncType(spec)
 	case *ast.InterfaceType:
 		l.checkFieldList(spec.Methods, "Method name")
+	case *ast.ArrayType:
+		l.checkTypeExpr(spec.Elt)
+	case *ast.MapType:
+		l.checkTypeExpr(spec.Key)
+		l.checkTypeExpr(spec.Value)
+	case *ast.ChanType:
+		l.checkTypeExpr(spec.Value)
+	case *ast.StarExpr:
+		l.checkTypeExpr(spec.X)
+	case *ast.ParenExpr:
+		l.checkTypeExpr(spec.X)
+	case *ast.Ellipsis:
+		l.checkTypeExpr(spec.Elt)
+	case *ast.IndexExpr:
+		l.checkTypeExpr(spec.Index)
+	case *ast.IndexListExpr:
+		for _, index := range spec.Indices {
+			l.checkTypeExpr(index)
+		}
 	}
 }
 
@@ -189,10 +466,13 @@ func (l *Linter) checkFieldList(fields *ast.FieldList, prefix string) {
 func (l *Linter) checkField(field *ast.Field, prefix string) {
 	l.checkIdents(field.Names, prefix)
 	l.checkTypeExpr(field.Type)
+	l.checkStructTag(field.Tag)
 }
 
 func (l *Linter) checkFuncDecl(funcDecl *ast.FuncDecl) {
+	reset := l.constrainNames(func() *nameConstraint { return l.funcNameConstraint(funcDecl) })
 	l.checkIdent(funcDecl.Name, "Function name")
+	reset()
 	l.checkFieldList(funcDecl.Recv, "Function receiver")
 	l.checkFuncType(funcDecl.Type)
 	l.checkBlockStmt(funcDecl.Body)
@@ -221,14 +501,19 @@ func (l *Linter) checkStmt(stmt ast.Stmt) {
 	case *ast.LabeledStmt:
 		l.checkLabelStmt(typedStmt)
 	case *ast.ExprStmt:
-		l.checkExprStmt(typedStmt)
+		l.checkUses(typedStmt.X)
 	case *ast.SendStmt:
+		l.checkUses(typedStmt.Chan, typedStmt.Value)
 	case *ast.IncDecStmt:
+		l.checkUses(typedStmt.X)
 	case *ast.AssignStmt:
 		l.checkAssignStmt(typedStmt)
 	case *ast.GoStmt:
+		l.checkUses(typedStmt.Call)
 	case *ast.DeferStmt:
+		l.checkUses(typedStmt.Call)
 	case *ast.ReturnStmt:
+		l.checkUses(typedStmt.Results...)
 	case *ast.BranchStmt:
 	case *ast.BlockStmt:
 		l.checkBlockStmt(typedStmt)
@@ -256,17 +541,20 @@ func (l *Linter) checkLabelStmt(stmt *ast.LabeledStmt) {
 	l.checkStmt(stmt.Stmt)
 }
 
-func (l *Linter) checkExprStmt(stmt *ast.ExprStmt) {
-	l.checkExpr(stmt.X)
-}
-
 func (l *Linter) checkAssignStmt(stmt *ast.AssignStmt) {
 	reset := l.suppressIssues(stmt.Tok != token.DEFINE)
 	l.checkExprs(stmt.Lhs)
 	reset()
 
-	reset = l.suppressIssues(true)
-	l.checkExprs(stmt.Rhs)
+	l.checkUses(stmt.Rhs...)
+}
+
+// checkUses checks expressions that only refer to existing identifiers.
+// Issues of these identifiers are suppressed, as they are reported at their declaration.
+// Function literals within the expressions declare their own identifiers, which are checked.
+func (l *Linter) checkUses(exprs ...ast.Expr) {
+	reset := l.suppressIssues(true)
+	l.checkExprs(exprs)
 	reset()
 }
 
@@ -284,37 +572,64 @@ func (l *Linter) checkExpr(expr ast.Expr) {
 	case *ast.Ident:
 		l.checkIdent(typedStmt, "Identifier")
 	case *ast.Ellipsis:
+		l.checkExpr(typedStmt.Elt)
 	case *ast.BasicLit:
+		l.checkStringLit(typedStmt, "String literal")
 	case *ast.FuncLit:
 		l.checkFuncLit(typedStmt)
 	case *ast.CompositeLit:
+		l.checkExpr(typedStmt.Type)
+		l.checkExprs(typedStmt.Elts)
 	case *ast.ParenExpr:
+		l.checkExpr(typedStmt.X)
 	case *ast.SelectorExpr:
+		l.checkExpr(typedStmt.X)
 	case *ast.IndexExpr:
+		l.checkExpr(typedStmt.X)
+		l.checkExpr(typedStmt.Index)
+	case *ast.IndexListExpr:
+		l.checkExpr(typedStmt.X)
+		l.checkExprs(typedStmt.Indices)
 	case *ast.SliceExpr:
+		l.checkExpr(typedStmt.X)
+		l.checkExpr(typedStmt.Low)
+		l.checkExpr(typedStmt.High)
+		l.checkExpr(typedStmt.Max)
 	case *ast.TypeAssertExpr:
+		l.checkExpr(typedStmt.X)
+		l.checkExpr(typedStmt.Type)
 	case *ast.CallExpr:
+		l.checkCallExpr(typedStmt)
 	case *ast.StarExpr:
+		l.checkExpr(typedStmt.X)
 	case *ast.UnaryExpr:
+		l.checkExpr(typedStmt.X)
 	case *ast.BinaryExpr:
+		l.checkExpr(typedStmt.X)
+		l.checkExpr(typedStmt.Y)
 	case *ast.KeyValueExpr:
+		l.checkExpr(typedStmt.Key)
+		l.checkExpr(typedStmt.Value)
+	case *ast.StructType, *ast.FuncType, *ast.InterfaceType, *ast.ArrayType, *ast.MapType, *ast.ChanType:
+		l.checkTypeExpr(typedStmt)
 	}
 }
 
 func (l *Linter) checkIfStmt(stmt *ast.IfStmt) {
 	l.checkStmt(stmt.Init)
+	l.checkUses(stmt.Cond)
 	l.checkBlockStmt(stmt.Body)
 	l.checkStmt(stmt.Else)
 }
 
 func (l *Linter) checkCaseClause(stmt *ast.CaseClause) {
-	l.checkExprs(stmt.List)
+	l.checkUses(stmt.List...)
 	l.checkStmts(stmt.Body)
 }
 
 func (l *Linter) checkSwitchStmt(stmt *ast.SwitchStmt) {
 	l.checkStmt(stmt.Init)
-	l.checkExpr(stmt.Tag)
+	l.checkUses(stmt.Tag)
 	l.checkBlockStmt(stmt.Body)
 }
 
@@ -335,15 +650,17 @@ func (l *Linter) checkSelectStmt(stmt *ast.SelectStmt) {
 
 func (l *Linter) checkForStmt(stmt *ast.ForStmt) {
 	l.checkStmt(stmt.Init)
-	l.checkExpr(stmt.Cond)
+	l.checkUses(stmt.Cond)
 	l.checkStmt(stmt.Post)
 	l.checkBlockStmt(stmt.Body)
 }
 
 func (l *Linter) checkRangeStmt(stmt *ast.RangeStmt) {
+	reset := l.suppressIssues(stmt.Tok != token.DEFINE)
 	l.checkExpr(stmt.Key)
 	l.checkExpr(stmt.Value)
-	l.checkExpr(stmt.X)
+	reset()
+	l.checkUses(stmt.X)
 	l.checkBlockStmt(stmt.Body)
 }
 
@@ -354,20 +671,45 @@ func (l *Linter) checkFuncLit(funcLit *ast.FuncLit) {
 	reset()
 }
 
-func (l *Linter) formatMessage(context, found string, phrase Phrase) string {
-	model := formatModel{
-		Context:      context,
-		Found:        found,
-		Alternatives: phrase.Alternatives,
-
-		PrintReferences: (l.settings.Formatting.WithReferences != nil) && *l.settings.Formatting.WithReferences,
+func containsString(list []string, s string) bool {
+	for _, entry := range list {
+		if entry == s {
+			return true
+		}
 	}
+	return false
+}
+
+func (l *Linter) resolveReferences(phrase Phrase) []Reference {
+	references := make([]Reference, 0, len(phrase.References))
 	for _, short := range phrase.References {
-		ref := referenceModel{
+		references = append(references, Reference{
 			Short: short,
 			Long:  l.settings.References[short],
-		}
-		model.References = append(model.References, ref)
+		})
+	}
+	return references
+}
+
+func (l *Linter) formatMessage(issue Issue, original string, constraint *nameConstraint) string {
+	filename := ""
+	if l.rawFile != nil {
+		filename = filepath.Base(l.rawFile.Name())
+	}
+	model := formatModel{
+		Context:      l.formatter.Translate(issue.Context),
+		Found:        issue.Found,
+		Original:     original,
+		PhraseID:     issue.PhraseID,
+		Alternatives: issue.Alternatives,
+		References:   issue.References,
+		Filename:     filename,
+		Declaration:  issue.Declaration,
+
+		PrintReferences: (l.settings.Formatting.WithReferences != nil) && *l.settings.Formatting.WithReferences,
+	}
+	if constraint != nil {
+		model.Constraint = l.formatter.Translate(constraint.message, constraint.args...)
 	}
 	return l.formatter.Format(model)
 }
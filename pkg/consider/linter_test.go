@@ -0,0 +1,266 @@
+package consider_test
+
+import (
+	"errors"
+	"go/parser"
+	"go/token"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/dertseha/goconsider/pkg/consider"
+)
+
+type issueCollector []consider.Issue
+
+func (c *issueCollector) Report(issue consider.Issue) {
+	*c = append(*c, issue)
+}
+
+func checkSource(t *testing.T, settings consider.Settings, src string) (*token.FileSet, []consider.Issue) {
+	t.Helper()
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, "source.go", src, parser.ParseComments)
+	if err != nil {
+		t.Fatalf("Failed to parse source: %v", err)
+	}
+	var issues issueCollector
+	linter := consider.NewLinter(settings, &issues)
+	linter.CheckFile(file, fset.File(file.Package))
+	return fset, issues
+}
+
+func abcdSettings(synonyms ...string) consider.Settings {
+	return consider.Settings{Phrases: []consider.Phrase{{Synonyms: synonyms}}}
+}
+
+func TestIssuesAreLocatedAtFoundPhrase(t *testing.T) {
+	src := `package source
+
+type someAbcdType int
+
+// This comment
+// mentions abcd
+// only later.
+var value = 1
+`
+	fset, issues := checkSource(t, abcdSettings("abcd"), src)
+	expected := []struct {
+		pos string
+		end string
+	}{
+		{pos: "source.go:6:13", end: "source.go:6:17"},
+		{pos: "source.go:3:10", end: "source.go:3:14"},
+	}
+	if len(issues) != len(expected) {
+		t.Fatalf("Unexpected issues %v", issues)
+	}
+	for index, issue := range issues {
+		if pos := fset.Position(issue.Pos).String(); pos != expected[index].pos {
+			t.Errorf("Issue %d has unexpected start %s", index, pos)
+		}
+		if end := fset.Position(issue.End).String(); end != expected[index].end {
+			t.Errorf("Issue %d has unexpected end %s", index, end)
+		}
+	}
+}
+
+func TestIssuesSpanningLinesAreLocatedAtFoundPhrase(t *testing.T) {
+	src := `package source
+
+// This is a
+// multi line phrase.
+var value = 1
+`
+	fset, issues := checkSource(t, abcdSettings("a multi line phrase"), src)
+	if len(issues) != 1 {
+		t.Fatalf("Unexpected issues %v", issues)
+	}
+	if pos := fset.Position(issues[0].Pos).String(); pos != "source.go:3:12" {
+		t.Errorf("Issue has unexpected start %s", pos)
+	}
+	if end := fset.Position(issues[0].End).String(); end != "source.go:4:21" {
+		t.Errorf("Issue has unexpected end %s", end)
+	}
+}
+
+func TestFormattingValidateRejectsInvalidTemplates(t *testing.T) {
+	templates := []string{
+		"{{.Context",
+		"{{.Unknown}}",
+		"{{unknown .Found}}",
+	}
+	for _, template := range templates {
+		err := consider.Formatting{Template: template}.Validate()
+		if !errors.Is(err, consider.ErrInvalidTemplate) {
+			t.Errorf("Template %q has unexpected error %v", template, err)
+		}
+	}
+}
+
+func TestFormattingValidateAcceptsDefault(t *testing.T) {
+	err := consider.Formatting{}.Validate()
+	if err != nil {
+		t.Errorf("Default template has error %v", err)
+	}
+}
+
+func TestInvalidTemplateIsDescribedInMessage(t *testing.T) {
+	settings := abcdSettings("abcd")
+	settings.Formatting.Template = "{{.Context"
+	_, issues := checkSource(t, settings, "package source\n\nvar abcd = 1\n")
+	if (len(issues) != 1) || !strings.HasPrefix(issues[0].Message, "failed to format message") {
+		t.Errorf("Unexpected issues %v", issues)
+	}
+}
+
+func TestIssuesHaveSeverityOfPhrase(t *testing.T) {
+	settings := consider.Settings{
+		Phrases: []consider.Phrase{
+			{Synonyms: []string{"abcd"}, Severity: consider.SeverityInfo},
+			{Synonyms: []string{"efgh"}},
+		},
+		Severity: consider.SeverityError,
+	}
+	_, issues := checkSource(t, settings, "package source\n\nvar abcd, efgh = 1, 2\n")
+	if len(issues) != 2 {
+		t.Fatalf("Unexpected issues %v", issues)
+	}
+	if issues[0].Severity != consider.SeverityInfo {
+		t.Errorf("Issue of phrase has unexpected severity %s", issues[0].Severity)
+	}
+	if issues[1].Severity != consider.SeverityError {
+		t.Errorf("Issue of phrase without severity has unexpected severity %s", issues[1].Severity)
+	}
+}
+
+func TestPhrasesAreSelectedByTags(t *testing.T) {
+	settings := consider.Settings{
+		Phrases: []consider.Phrase{
+			{Synonyms: []string{"abcd"}, Tags: []string{"first"}},
+			{Synonyms: []string{"efgh"}, Tags: []string{"first", "second"}},
+			{Synonyms: []string{"bad"}},
+		},
+	}
+	src := "package source\n\nvar abcd, efgh, bad = 1, 2, 3\n"
+	tests := []struct {
+		enable   []string
+		disable  []string
+		expected []string
+	}{
+		{expected: []string{"abcd", "efgh", "bad"}},
+		{enable: []string{"first"}, expected: []string{"abcd", "efgh"}},
+		{disable: []string{"second"}, expected: []string{"abcd", "bad"}},
+		{enable: []string{"first"}, disable: []string{"second"}, expected: []string{"abcd"}},
+	}
+	for _, tc := range tests {
+		settings.EnableTags = tc.enable
+		settings.DisableTags = tc.disable
+		_, issues := checkSource(t, settings, src)
+		var found []string
+		for _, issue := range issues {
+			found = append(found, issue.Found)
+		}
+		if strings.Join(found, ",") != strings.Join(tc.expected, ",") {
+			t.Errorf("Tags %v/%v found unexpected phrases %v", tc.enable, tc.disable, found)
+		}
+	}
+}
+
+func TestPhrasesAreDisabledByPathRules(t *testing.T) {
+	settings := consider.Settings{
+		Phrases: []consider.Phrase{
+			{Synonyms: []string{"abcd"}},
+			{ID: "second", Synonyms: []string{"efgh"}},
+		},
+		PathRules: []consider.PathRule{
+			{Paths: []string{"source.go"}, Disable: []string{"second"}},
+			{Paths: []string{"other.go"}, Disable: []string{"abcd"}},
+		},
+	}
+	_, issues := checkSource(t, settings, "package source\n\nvar abcd, efgh = 1, 2\n")
+	if (len(issues) != 1) || (issues[0].PhraseID != "abcd") {
+		t.Errorf("Unexpected issues %v", issues)
+	}
+}
+
+func TestPathPatternsAreMatchedWithinRootDir(t *testing.T) {
+	rootDir := filepath.Join(string(filepath.Separator), "home", "internal", "project")
+	settings := abcdSettings("abcd")
+	settings.Exclude = []string{"internal/"}
+	check := func(filename string) []consider.Issue {
+		fset := token.NewFileSet()
+		file, err := parser.ParseFile(fset, filename, "package source\n\nvar abcd = 1\n", parser.ParseComments)
+		if err != nil {
+			t.Fatalf("Failed to parse source: %v", err)
+		}
+		var issues issueCollector
+		linter := consider.NewLinter(settings, &issues)
+		linter.SetRootDir(rootDir)
+		linter.CheckFile(file, fset.File(file.Package))
+		return issues
+	}
+	if issues := check(filepath.Join(rootDir, "source.go")); len(issues) != 1 {
+		t.Errorf("Unexpected issues %v for prefix above root", issues)
+	}
+	if issues := check(filepath.Join(rootDir, "internal", "source.go")); len(issues) != 0 {
+		t.Errorf("Unexpected issues %v for prefix within root", issues)
+	}
+}
+
+func TestStringsAreOnlyCheckedIfEnabled(t *testing.T) {
+	src := "package source\n\nvar value = \"some abcd\"\n"
+	settings := abcdSettings("abcd")
+	if _, issues := checkSource(t, settings, src); len(issues) != 0 {
+		t.Errorf("Unexpected issues %v", issues)
+	}
+	checkStrings := true
+	settings.CheckStrings = &checkStrings
+	_, issues := checkSource(t, settings, src)
+	if (len(issues) != 1) || (issues[0].Context != "String literal") {
+		t.Errorf("Unexpected issues %v", issues)
+	}
+}
+
+func TestIssuesInStringsAreLocatedWithinSource(t *testing.T) {
+	src := "package source\n\nvar value = \"\\t\\u00e4 abcd\"\n"
+	checkStrings := true
+	settings := abcdSettings("abcd")
+	settings.CheckStrings = &checkStrings
+	fset, issues := checkSource(t, settings, src)
+	if len(issues) != 1 {
+		t.Fatalf("Unexpected issues %v", issues)
+	}
+	if pos, end := fset.Position(issues[0].Pos).String(), fset.Position(issues[0].End).String(); (pos != "source.go:3:23") || (end != "source.go:3:27") {
+		t.Errorf("Issue has unexpected location %s - %s", pos, end)
+	}
+}
+
+func TestIssuesInStructTagsHaveSeverityOfSettings(t *testing.T) {
+	src := "package source\n\ntype value struct {\n\tField int `json:\"id\" db:\"some_abcd\"`\n}\n"
+	settings := abcdSettings("abcd")
+	settings.StructTags.Severity = consider.SeverityError
+	fset, issues := checkSource(t, settings, src)
+	if len(issues) != 1 {
+		t.Fatalf("Unexpected issues %v", issues)
+	}
+	if (issues[0].Context != "Database column name") || (issues[0].Severity != consider.SeverityError) || (len(issues[0].Renames) != 0) {
+		t.Errorf("Unexpected issue %v", issues[0])
+	}
+	if issues[0].PhraseSeverity != consider.SeverityWarning {
+		t.Errorf("Issue has unexpected phrase severity %s", issues[0].PhraseSeverity)
+	}
+	if pos := fset.Position(issues[0].Pos).String(); pos != "source.go:4:32" {
+		t.Errorf("Issue has unexpected location %s", pos)
+	}
+}
+
+func TestStructTagsAreNotCheckedIfDisabled(t *testing.T) {
+	src := "package source\n\ntype value struct {\n\tField int `json:\"abcd\"`\n}\n"
+	check := false
+	settings := abcdSettings("abcd")
+	settings.StructTags.Check = &check
+	if _, issues := checkSource(t, settings, src); len(issues) != 0 {
+		t.Errorf("Unexpected issues %v", issues)
+	}
+}
@@ -0,0 +1,86 @@
+package consider
+
+import (
+	"path"
+	"path/filepath"
+	"strings"
+)
+
+// SetRootDir provides the root directory of the checked files, typically the directory of the module.
+// Path patterns are matched against the paths of the files relative to it, so that directories above the root,
+// such as those of the checkout, are not considered. Without it, the full paths of the files are matched.
+func (l *Linter) SetRootDir(dir string) {
+	l.rootDir = dir
+}
+
+// relativeFilename returns the path of given file relative to the root directory.
+// The path is returned unchanged if there is no root directory, or if the file is not within it.
+func (l *Linter) relativeFilename(filename string) string {
+	if len(l.rootDir) == 0 {
+		return filename
+	}
+	relative, err := filepath.Rel(l.rootDir, filename)
+	if (err != nil) || (relative == "..") || strings.HasPrefix(relative, ".."+string(filepath.Separator)) {
+		return filename
+	}
+	return relative
+}
+
+// fileSelected returns true if the given file shall be checked according to the include and exclude patterns.
+// If include patterns are given, at least one of them must match. None of the exclude patterns may match.
+func fileSelected(include, exclude []string, filename string) bool {
+	if (len(include) > 0) && !anyPathMatches(include, filename) {
+		return false
+	}
+	return !anyPathMatches(exclude, filename)
+}
+
+// disabledByPathRules returns true if any path rule that matches the file disables the phrase.
+func disabledByPathRules(rules []PathRule, filename string, phrase Phrase) bool {
+	for _, rule := range rules {
+		if anyPathMatches(rule.Paths, filename) && containsString(rule.Disable, phrase.Identifier()) {
+			return true
+		}
+	}
+	return false
+}
+
+func anyPathMatches(patterns []string, filename string) bool {
+	for _, pattern := range patterns {
+		if pathMatches(pattern, filename) {
+			return true
+		}
+	}
+	return false
+}
+
+// pathMatches returns true if the glob pattern matches any consecutive segments of the file path.
+// A pattern such as "*_gen.go" matches a file name in any directory, and "testing/data" matches these
+// segments anywhere in the path. Patterns that end with a slash, such as "third_party/", only match directories.
+// Individual segments are matched with the rules of path.Match.
+func pathMatches(pattern, filename string) bool {
+	if len(pattern) == 0 {
+		return false
+	}
+	segments := strings.Split(strings.Trim(filepath.ToSlash(filename), "/"), "/")
+	patternSegments := strings.Split(strings.Trim(pattern, "/"), "/")
+	last := len(segments)
+	if strings.HasSuffix(pattern, "/") {
+		last--
+	}
+	for start := 0; start+len(patternSegments) <= last; start++ {
+		if segmentsMatch(patternSegments, segments[start:start+len(patternSegments)]) {
+			return true
+		}
+	}
+	return false
+}
+
+func segmentsMatch(patterns, segments []string) bool {
+	for index, pattern := range patterns {
+		if matched, err := path.Match(pattern, segments[index]); (err != nil) || !matched {
+			return false
+		}
+	}
+	return true
+}
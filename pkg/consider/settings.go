@@ -1,7 +1,43 @@
 package consider
 
+import "strings"
+
+const (
+	// OccurrencesFirst reports only the first occurrence of each synonym within a text.
+	OccurrencesFirst = "first"
+	// OccurrencesAll reports all occurrences of the synonyms within a text.
+	OccurrencesAll = "all"
+
+	// SeverityError is the severity of findings that shall fail a build.
+	SeverityError = "error"
+	// SeverityWarning is the default severity of findings.
+	SeverityWarning = "warning"
+	// SeverityInfo is the severity of findings that are only informational.
+	SeverityInfo = "info"
+)
+
+// severityRanks lists the known severities, from least to most severe.
+var severityRanks = []string{SeverityInfo, SeverityWarning, SeverityError}
+
+// SeverityRank returns the rank of given severity. Higher ranks are more severe.
+// The second return value is false for unknown severities.
+func SeverityRank(severity string) (int, bool) {
+	for rank, known := range severityRanks {
+		if known == severity {
+			return rank, true
+		}
+	}
+	return -1, false
+}
+
 // Settings contain all the parameters for the analysis.
 type Settings struct {
+	// Extends lists settings these are based on, which are merged in order before these settings.
+	// Entries are either "default" for the default settings, or names of settings files.
+	// Extension is resolved when reading settings files, see package settings.
+	Extends []string `yaml:"extends"`
+	// RemovePhrases lists the identifiers of phrases of the extended settings that shall be removed.
+	RemovePhrases []string `yaml:"removePhrases"`
 	// References is a key-value map of short keys to a reference, typically a stable link.
 	// They indicate resources that can help understand why phrases are flagged, or
 	// at least give examples of other (larger) peer groups that considered rewording.
@@ -10,16 +46,111 @@ type Settings struct {
 	Phrases []Phrase `yaml:"phrases"`
 	// Formatting describes how the messages shall be formatted.
 	Formatting Formatting `yaml:"formatting"`
+	// Fixes describes which fixes shall be suggested.
+	Fixes Fixes `yaml:"fixes"`
+	// Directives describes how suppression directives in comments are handled.
+	Directives Directives `yaml:"directives"`
+	// Occurrences specifies which occurrences of a phrase are reported within one text, such as an identifier
+	// or a comment block. Possible values are OccurrencesFirst, the default, and OccurrencesAll.
+	Occurrences string `yaml:"occurrences"`
+	// Include lists glob patterns of files that shall be checked. All files are checked if empty.
+	// A pattern matches consecutive segments anywhere in the path, such as "*_test.go" or "internal/".
+	// Patterns ending with a slash only match directories.
+	Include []string `yaml:"include"`
+	// Exclude lists glob patterns of files that shall not be checked. The format is the same as for Include.
+	Exclude []string `yaml:"exclude"`
+	// EnableTags lists the tags of phrases that shall be looked for. All phrases are looked for if empty.
+	// A phrase is looked for if it has at least one of these tags.
+	EnableTags []string `yaml:"enableTags"`
+	// DisableTags lists the tags of phrases that shall not be looked for, even if also enabled.
+	DisableTags []string `yaml:"disableTags"`
+	// PathRules change which phrases are looked for in certain files.
+	PathRules []PathRule `yaml:"pathRules"`
+	// SkipGenerated indicates whether generated files shall be skipped. This is the default.
+	// Generated files are marked with a comment "// Code generated ... DO NOT EDIT." before the package clause.
+	SkipGenerated *bool `yaml:"skipGenerated"`
+	// CheckGeneratedComments indicates whether comments in skipped generated files shall still be checked.
+	// Comments may originate from handwritten sources, such as protobuf definitions, while identifiers
+	// are typically dictated by the generator.
+	CheckGeneratedComments *bool `yaml:"checkGeneratedComments"`
+	// CheckStrings indicates whether string literals shall be checked. They are not checked by default.
+	CheckStrings *bool `yaml:"checkStrings"`
+	// StringFunctions lists additional functions whose string arguments are user-facing texts.
+	StringFunctions StringFunctions `yaml:"stringFunctions"`
+	// ExternalNames describes how names are handled that are dictated by external code.
+	ExternalNames ExternalNames `yaml:"externalNames"`
+	// StructTags describes how the values of struct tags are checked.
+	StructTags StructTags `yaml:"structTags"`
+	// Severity is the severity of phrases that do not specify one. SeverityWarning if empty.
+	// It is also the severity of findings that are not about a phrase, such as unused directives.
+	Severity string `yaml:"severity"`
+	// Baseline is the path to a file of known issues, which shall not be reported.
+	// If the settings are read from a file, a relative path is resolved from the directory of that file.
+	Baseline string `yaml:"baseline"`
+}
+
+// SkipsGenerated returns true if generated files shall be skipped.
+func (s Settings) SkipsGenerated() bool {
+	return (s.SkipGenerated == nil) || *s.SkipGenerated
+}
+
+// SeverityOf returns the severity of given phrase, considering the default severity.
+func (s Settings) SeverityOf(phrase Phrase) string {
+	if len(phrase.Severity) != 0 {
+		return phrase.Severity
+	}
+	return s.DefaultSeverity()
+}
+
+// DefaultSeverity returns the severity of phrases that do not specify one.
+func (s Settings) DefaultSeverity() string {
+	if len(s.Severity) != 0 {
+		return s.Severity
+	}
+	return SeverityWarning
+}
+
+// ChecksStrings returns true if string literals shall be checked.
+func (s Settings) ChecksStrings() bool {
+	return (s.CheckStrings != nil) && *s.CheckStrings
+}
+
+// ChecksGeneratedComments returns true if comments of skipped generated files shall still be checked.
+func (s Settings) ChecksGeneratedComments() bool {
+	return (s.CheckGeneratedComments != nil) && *s.CheckGeneratedComments
 }
 
 // Phrase describes an expression, with optional alternatives, that the linter flags.
 type Phrase struct {
+	// ID optionally identifies the phrase, for example as a rule in reports.
+	// If empty, an identifier is derived from the first synonym.
+	ID string `yaml:"id"`
 	// Synonyms are one or more expressions that have the same meaning and proposed alternatives.
 	Synonyms []string `yaml:"synonyms"`
 	// Alternatives are zero, one, or more expressions that are provided as replacement.
 	Alternatives []string `yaml:"alternatives"`
 	// References is a list of either direct, or keyed references into the global map of references.
 	References []string `yaml:"references"`
+	// Tags categorize the phrase, such as "inclusive" or "project-terminology".
+	// They allow to select phrases with EnableTags and DisableTags of the settings.
+	Tags []string `yaml:"tags"`
+	// Severity is one of SeverityError, SeverityWarning, or SeverityInfo.
+	// If empty, the default severity of the settings applies.
+	Severity string `yaml:"severity"`
+	// Include lists glob patterns of files in which the phrase shall be looked for. All files if empty.
+	// The format is the same as for the Include patterns of the settings.
+	Include []string `yaml:"include"`
+	// Exclude lists glob patterns of files in which the phrase shall not be looked for.
+	Exclude []string `yaml:"exclude"`
+}
+
+// PathRule changes which phrases are looked for in certain files.
+type PathRule struct {
+	// Paths lists glob patterns of files the rule applies to. The format is the same as for the Include patterns
+	// of the settings.
+	Paths []string `yaml:"paths"`
+	// Disable lists the identifiers of phrases that shall not be looked for in these files.
+	Disable []string `yaml:"disable"`
 }
 
 // Formatting descries how messages shall be formatted.
@@ -27,4 +158,99 @@ type Formatting struct {
 	// WithReferences indicates whether the long-form of references shall be added.
 	// This is not done by default as this is done in separate lines.
 	WithReferences *bool `yaml:"withReferences"`
+	// Template is a text/template that replaces the built-in message format.
+	// See the README for the available fields and functions.
+	Template string `yaml:"template"`
+	// TemplateFile is the path to a file with the template. It is an alternative to Template.
+	// If the settings are read from a file, a relative path is resolved from the directory of that file.
+	TemplateFile string `yaml:"templateFile"`
+	// Locale selects the language of the messages, such as "de" or "ja". English is the default.
+	// Messages without translation are in English.
+	Locale string `yaml:"locale"`
+	// Catalog maps messages in their English form to translations. These entries take precedence
+	// over the bundled catalog of the locale. See the bundled English catalog for the available messages.
+	Catalog map[string]string `yaml:"catalog"`
+	// CatalogFile is the path to a YAML file with a catalog. It is an alternative to Catalog.
+	// If the settings are read from a file, a relative path is resolved from the directory of that file.
+	CatalogFile string `yaml:"catalogFile"`
+}
+
+// StringFunctions lists functions whose string arguments are user-facing texts, in addition to the built-in ones
+// of the standard library. String arguments of these functions are reported with a corresponding context.
+// Functions are given by their full name, such as "github.com/some/errors.New" for a function,
+// or "(*github.com/some/log.Logger).Infof" for a method.
+type StringFunctions struct {
+	// Errors lists functions that create errors, such as "errors.New". The context is "Error message".
+	Errors []string `yaml:"errors"`
+	// Logs lists functions that log messages, such as "log.Printf". The context is "Log message".
+	Logs []string `yaml:"logs"`
+	// Tests lists functions that run named tests, such as "(*testing.T).Run". The context is "Test name".
+	Tests []string `yaml:"tests"`
+	// Help lists functions that define command line flags, such as "flag.String". The context is "Help text".
+	Help []string `yaml:"help"`
+}
+
+// StructTags describes how the values of struct tags, such as `json:"name"`, are checked.
+type StructTags struct {
+	// Check indicates whether struct tags shall be checked. This is the default.
+	Check *bool `yaml:"check"`
+	// Severity is the severity of findings in struct tags. If empty, the severity of the phrase applies.
+	// Struct tags typically name serialized data, such as JSON fields or database columns, which cannot be
+	// renamed without breaking compatibility. A separate severity allows to handle such findings differently.
+	Severity string `yaml:"severity"`
+}
+
+// Checks returns true if struct tags shall be checked.
+func (tags StructTags) Checks() bool {
+	return (tags.Check == nil) || *tags.Check
+}
+
+// ExternalNames describes how names are handled that are dictated by external code.
+// These are names of methods that implement an interface declared outside the module, of functions exported to C
+// with a cgo "//export" directive, and of functions referenced by a "//go:linkname" directive.
+// Such names cannot be renamed, which is why no fixes are suggested for them.
+type ExternalNames struct {
+	// Severity is the severity of findings in such names, which then explain why the name is dictated.
+	// If empty, findings in such names are not reported.
+	Severity string `yaml:"severity"`
+}
+
+// Reports returns true if findings in names that are dictated by external code shall be reported.
+func (names ExternalNames) Reports() bool {
+	return len(names.Severity) != 0
+}
+
+// Fixes describes which fixes shall be suggested.
+type Fixes struct {
+	// RenameExported indicates whether renames shall also be proposed for exported identifiers.
+	// These are not proposed by default, as such renames break code in other packages.
+	RenameExported *bool `yaml:"renameExported"`
+}
+
+// RenamesExported returns true if renames of exported identifiers shall be proposed.
+func (fixes Fixes) RenamesExported() bool {
+	return (fixes.RenameExported != nil) && *fixes.RenameExported
+}
+
+// Directives describes how suppression directives in comments are handled.
+type Directives struct {
+	// ReportUnused indicates whether directives that did not suppress any issue shall be reported.
+	ReportUnused *bool `yaml:"reportUnused"`
+}
+
+// ReportsUnused returns true if unused directives shall be reported.
+func (directives Directives) ReportsUnused() bool {
+	return (directives.ReportUnused != nil) && *directives.ReportUnused
+}
+
+// Identifier returns the ID of the phrase, or an identifier derived from its first synonym if no ID is set.
+// A derived identifier has the words of the synonym joined with hyphens, such as "some-phrase".
+func (phrase Phrase) Identifier() string {
+	if len(phrase.ID) != 0 {
+		return phrase.ID
+	}
+	if len(phrase.Synonyms) == 0 {
+		return ""
+	}
+	return strings.Join(strings.Fields(phrase.Synonyms[0]), "-")
 }
@@ -0,0 +1,142 @@
+package consider
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+	"strconv"
+
+	"github.com/dertseha/goconsider/internal/text"
+	"golang.org/x/tools/go/types/typeutil"
+)
+
+// Functions whose string arguments are user-facing texts, by their full name as given by types.Func.FullName.
+var (
+	defaultErrorFunctions = []string{"errors.New", "fmt.Errorf"}
+	defaultLogFunctions   = append(
+		qualifiedFunctions("log", "(*log.Logger)",
+			"Print", "Printf", "Println", "Fatal", "Fatalf", "Fatalln", "Panic", "Panicf", "Panicln"),
+		qualifiedFunctions("log/slog", "(*log/slog.Logger)", "Debug", "Info", "Warn", "Error")...)
+	defaultTestFunctions = []string{"(*testing.T).Run", "(*testing.B).Run"}
+	defaultHelpFunctions = qualifiedFunctions("flag", "(*flag.FlagSet)",
+		"Bool", "BoolVar", "Duration", "DurationVar", "Float64", "Float64Var", "Func", "Int", "IntVar", "Int64", "Int64Var",
+		"String", "StringVar", "TextVar", "Uint", "UintVar", "Uint64", "Uint64Var", "Var")
+)
+
+// qualifiedFunctions returns the full names of the functions of a package and the methods of a type.
+func qualifiedFunctions(pkg string, receiver string, names ...string) []string {
+	functions := make([]string, 0, 2*len(names))
+	for _, name := range names {
+		functions = append(functions, pkg+"."+name, receiver+"."+name)
+	}
+	return functions
+}
+
+// SetTypesInfo provides the type information of the checked files.
+// It is used to determine the functions that string literals are passed to. Without it, string literals
+// are only checked with the generic context "String literal".
+func (l *Linter) SetTypesInfo(info *types.Info) {
+	l.typesInfo = info
+}
+
+// stringContextOf returns the context of string literals passed as arguments to given call.
+// An empty string is returned if the called function is not known to take user-facing texts.
+func (l *Linter) stringContextOf(call *ast.CallExpr) string {
+	if l.typesInfo == nil {
+		return ""
+	}
+	callee, isFunc := typeutil.Callee(l.typesInfo, call).(*types.Func)
+	if !isFunc {
+		return ""
+	}
+	name := callee.FullName()
+	functions := l.settings.StringFunctions
+	switch {
+	case containsString(defaultErrorFunctions, name) || containsString(functions.Errors, name):
+		return "Error message"
+	case containsString(defaultLogFunctions, name) || containsString(functions.Logs, name):
+		return "Log message"
+	case containsString(defaultTestFunctions, name) || containsString(functions.Tests, name):
+		return "Test name"
+	case containsString(defaultHelpFunctions, name) || containsString(functions.Help, name):
+		return "Help text"
+	}
+	return ""
+}
+
+func (l *Linter) checkCallExpr(call *ast.CallExpr) {
+	l.checkExpr(call.Fun)
+	context := ""
+	if l.settings.ChecksStrings() {
+		context = l.stringContextOf(call)
+	}
+	for _, arg := range call.Args {
+		if lit, isLit := arg.(*ast.BasicLit); isLit && (len(context) != 0) {
+			l.checkStringLit(lit, context)
+			continue
+		}
+		l.checkExpr(arg)
+	}
+}
+
+// checkStringLit checks the value of a string literal, if strings shall be checked.
+// Issues are located at the found phrase within the source of the literal, also if it contains escape sequences.
+func (l *Linter) checkStringLit(lit *ast.BasicLit, context string) {
+	if (lit.Kind != token.STRING) || !l.settings.ChecksStrings() {
+		return
+	}
+	value, offsets, valid := unquote(lit.Value)
+	if !valid {
+		return
+	}
+	reset := l.suppressIssues(false)
+	l.checkText(value, context, nil, func(match text.Match) (token.Pos, token.Pos) {
+		return lit.ValuePos + token.Pos(offsets[match.Start]), lit.ValuePos + token.Pos(offsets[match.End])
+	})
+	reset()
+}
+
+// unquote returns the value of a Go string literal, together with the offset within the literal of each byte
+// of the value. The offsets have one additional entry for the end of the value.
+func unquote(lit string) (string, []int, bool) {
+	if len(lit) < 2 {
+		return "", nil, false
+	}
+	quote := lit[0]
+	inner := lit[1 : len(lit)-1]
+	if quote == '`' {
+		offsets := make([]int, 0, len(inner)+1)
+		var value []byte
+		for index := 0; index < len(inner); index++ {
+			if inner[index] == '\r' {
+				continue
+			}
+			value = append(value, inner[index])
+			offsets = append(offsets, 1+index)
+		}
+		return string(value), append(offsets, len(lit)-1), true
+	}
+	if quote != '"' {
+		return "", nil, false
+	}
+	var value []byte
+	var offsets []int
+	remaining := inner
+	for len(remaining) > 0 {
+		offset := len(lit) - 1 - len(remaining)
+		r, multibyte, tail, err := strconv.UnquoteChar(remaining, '"')
+		if err != nil {
+			return "", nil, false
+		}
+		encoded := []byte{byte(r)}
+		if multibyte {
+			encoded = []byte(string(r))
+		}
+		for range encoded {
+			offsets = append(offsets, offset)
+		}
+		value = append(value, encoded...)
+		remaining = tail
+	}
+	return string(value), append(offsets, len(lit)-1), true
+}
@@ -0,0 +1,103 @@
+package consider
+
+import (
+	"go/ast"
+	"go/token"
+	"strings"
+
+	"github.com/dertseha/goconsider/internal/text"
+)
+
+// structTagContexts maps the keys of well-known struct tags to the context of their findings.
+// Values of other keys have the context "Struct tag".
+var structTagContexts = map[string]string{
+	"json": "JSON field name",
+	"yaml": "YAML field name",
+	"xml":  "XML element name",
+	"toml": "TOML key",
+	"db":   "Database column name",
+	"bson": "BSON field name",
+}
+
+// structTagNameKeys lists the keys of struct tags whose values are a name followed by comma-separated options,
+// such as `json:"name,omitempty"`. Only the name is checked for them.
+var structTagNameKeys = []string{"json", "yaml", "xml", "toml", "bson"}
+
+// checkStructTag checks the values of all keys of the tag of a struct member.
+// The tag is parsed following the conventions of reflect.StructTag. Parsing stops at the first malformed entry.
+// Findings have the severity for struct tags from the settings, if set, as the values typically name
+// serialized data, which cannot be renamed without breaking compatibility.
+func (l *Linter) checkStructTag(lit *ast.BasicLit) {
+	if (lit == nil) || !l.settings.StructTags.Checks() {
+		return
+	}
+	tag, tagOffsets, valid := unquote(lit.Value)
+	if !valid {
+		return
+	}
+	resetSeverity := l.overrideSeverity(l.settings.StructTags.Severity)
+	defer resetSeverity()
+	resetSuppression := l.suppressIssues(false)
+	defer resetSuppression()
+	for _, entry := range structTagEntries(tag) {
+		value, valueOffsets, valid := unquote(tag[entry.start:entry.end])
+		if !valid {
+			return
+		}
+		if containsString(structTagNameKeys, entry.key) {
+			value, _, _ = strings.Cut(value, ",")
+		}
+		context, known := structTagContexts[entry.key]
+		if !known {
+			context = "Struct tag"
+		}
+		locate := func(offset int) token.Pos {
+			return lit.ValuePos + token.Pos(tagOffsets[entry.start+valueOffsets[offset]])
+		}
+		l.checkText(value, context, nil, func(match text.Match) (token.Pos, token.Pos) {
+			return locate(match.Start), locate(match.End)
+		})
+	}
+}
+
+// structTagEntry is a key of a struct tag, with the location of its quoted value within the tag.
+type structTagEntry struct {
+	key   string
+	start int
+	end   int
+}
+
+// structTagEntries returns the entries of a tag of the form `key:"value" other:"value"`.
+// This follows the parsing of reflect.StructTag.Lookup.
+func structTagEntries(tag string) []structTagEntry {
+	var entries []structTagEntry
+	offset := 0
+	for offset < len(tag) {
+		for (offset < len(tag)) && (tag[offset] == ' ') {
+			offset++
+		}
+		keyStart := offset
+		for (offset < len(tag)) && (tag[offset] > ' ') && (tag[offset] != ':') && (tag[offset] != '"') && (tag[offset] != 0x7f) {
+			offset++
+		}
+		if (offset == keyStart) || (offset+1 >= len(tag)) || (tag[offset] != ':') || (tag[offset+1] != '"') {
+			break
+		}
+		key := tag[keyStart:offset]
+		offset++
+		valueStart := offset
+		offset++
+		for (offset < len(tag)) && (tag[offset] != '"') {
+			if tag[offset] == '\\' {
+				offset++
+			}
+			offset++
+		}
+		if offset >= len(tag) {
+			break
+		}
+		offset++
+		entries = append(entries, structTagEntry{key: key, start: valueStart, end: offset})
+	}
+	return entries
+}
@@ -0,0 +1,20 @@
+package consider
+
+// tagsSelected returns true if a phrase with given tags shall be looked for according to the enabled and
+// disabled tags. If enabled tags are given, the phrase must have at least one of them.
+// The phrase must not have any of the disabled tags.
+func tagsSelected(enabled, disabled []string, tags []string) bool {
+	if (len(enabled) > 0) && !anyTagIn(enabled, tags) {
+		return false
+	}
+	return !anyTagIn(disabled, tags)
+}
+
+func anyTagIn(list []string, tags []string) bool {
+	for _, tag := range tags {
+		if containsString(list, tag) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,57 @@
+package consider
+
+import (
+	"errors"
+	"fmt"
+)
+
+var (
+	// ErrUnknownSeverity is returned for severities other than SeverityError, SeverityWarning, and SeverityInfo.
+	ErrUnknownSeverity = errors.New("unknown severity")
+	// ErrDuplicatePhraseID is returned if several phrases have the same identifier.
+	ErrDuplicatePhraseID = errors.New("duplicate phrase identifier")
+	// ErrUnknownPhraseID is returned for references to phrases that do not exist.
+	ErrUnknownPhraseID = errors.New("unknown phrase identifier")
+	// ErrUnknownOccurrences is returned for occurrences other than OccurrencesFirst and OccurrencesAll.
+	ErrUnknownOccurrences = errors.New("unknown occurrences")
+)
+
+// Validate returns an error if the settings are inconsistent, such as for unknown severities, unknown occurrences,
+// or duplicate phrase identifiers. See also Formatting.Validate, which is included.
+func (s Settings) Validate() error {
+	if err := s.Formatting.Validate(); err != nil {
+		return err
+	}
+	if (len(s.Occurrences) != 0) && (s.Occurrences != OccurrencesFirst) && (s.Occurrences != OccurrencesAll) {
+		return fmt.Errorf("%w: '%s', expected '%s' or '%s'", ErrUnknownOccurrences, s.Occurrences, OccurrencesFirst, OccurrencesAll)
+	}
+	if _, known := SeverityRank(s.DefaultSeverity()); !known {
+		return fmt.Errorf("%w: '%s'", ErrUnknownSeverity, s.DefaultSeverity())
+	}
+	if _, known := SeverityRank(s.StructTags.Severity); !known && (len(s.StructTags.Severity) != 0) {
+		return fmt.Errorf("%w: '%s' for struct tags", ErrUnknownSeverity, s.StructTags.Severity)
+	}
+	if _, known := SeverityRank(s.ExternalNames.Severity); !known && s.ExternalNames.Reports() {
+		return fmt.Errorf("%w: '%s' for external names", ErrUnknownSeverity, s.ExternalNames.Severity)
+	}
+	ids := make(map[string]int)
+	for index, phrase := range s.Phrases {
+		id := phrase.Identifier()
+		if previous, exists := ids[id]; exists {
+			return fmt.Errorf("%w: '%s' of phrases at index %d and %d, consider setting an explicit id",
+				ErrDuplicatePhraseID, id, previous, index)
+		}
+		ids[id] = index
+		if _, known := SeverityRank(s.SeverityOf(phrase)); !known {
+			return fmt.Errorf("%w: '%s' for phrase '%s'", ErrUnknownSeverity, phrase.Severity, id)
+		}
+	}
+	for _, rule := range s.PathRules {
+		for _, id := range rule.Disable {
+			if _, exists := ids[id]; !exists {
+				return fmt.Errorf("%w: '%s' in path rule", ErrUnknownPhraseID, id)
+			}
+		}
+	}
+	return nil
+}
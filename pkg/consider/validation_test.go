@@ -0,0 +1,65 @@
+package consider_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/dertseha/goconsider/pkg/consider"
+)
+
+func TestSettingsValidateAcceptsUniqueIdentifiers(t *testing.T) {
+	settings := consider.Settings{
+		Phrases: []consider.Phrase{
+			{Synonyms: []string{"abcd"}},
+			{ID: "abcd-other", Synonyms: []string{"abcd efgh"}},
+		},
+		PathRules: []consider.PathRule{{Paths: []string{"*_test.go"}, Disable: []string{"abcd-other"}}},
+	}
+	if err := settings.Validate(); err != nil {
+		t.Errorf("Unexpected error %v", err)
+	}
+}
+
+func TestSettingsValidateRejectsDuplicateIdentifiers(t *testing.T) {
+	settings := consider.Settings{
+		Phrases: []consider.Phrase{
+			{Synonyms: []string{"abcd efgh"}},
+			{ID: "abcd-efgh", Synonyms: []string{"efgh"}},
+		},
+	}
+	if err := settings.Validate(); !errors.Is(err, consider.ErrDuplicatePhraseID) {
+		t.Errorf("Unexpected error %v", err)
+	}
+}
+
+func TestSettingsValidateRejectsUnknownIdentifiersInPathRules(t *testing.T) {
+	settings := consider.Settings{
+		Phrases:   []consider.Phrase{{Synonyms: []string{"abcd"}}},
+		PathRules: []consider.PathRule{{Paths: []string{"*_test.go"}, Disable: []string{"efgh"}}},
+	}
+	if err := settings.Validate(); !errors.Is(err, consider.ErrUnknownPhraseID) {
+		t.Errorf("Unexpected error %v", err)
+	}
+}
+
+func TestSettingsValidateRejectsUnknownSeverities(t *testing.T) {
+	settings := consider.Settings{Phrases: []consider.Phrase{{Synonyms: []string{"abcd"}, Severity: "fatal"}}}
+	if err := settings.Validate(); !errors.Is(err, consider.ErrUnknownSeverity) {
+		t.Errorf("Unexpected error %v", err)
+	}
+}
+
+func TestSettingsValidateRejectsUnknownOccurrences(t *testing.T) {
+	for _, occurrences := range []string{"All", "every"} {
+		settings := consider.Settings{Occurrences: occurrences}
+		if err := settings.Validate(); !errors.Is(err, consider.ErrUnknownOccurrences) {
+			t.Errorf("Unexpected error %v for '%s'", err, occurrences)
+		}
+	}
+	for _, occurrences := range []string{"", consider.OccurrencesFirst, consider.OccurrencesAll} {
+		settings := consider.Settings{Occurrences: occurrences}
+		if err := settings.Validate(); err != nil {
+			t.Errorf("Unexpected error %v for '%s'", err, occurrences)
+		}
+	}
+}
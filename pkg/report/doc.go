@@ -0,0 +1,2 @@
+// Package report contains writers that present issues in various formats, for humans and for tools.
+package report
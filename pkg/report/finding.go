@@ -0,0 +1,83 @@
+package report
+
+import (
+	"go/token"
+
+	"github.com/dertseha/goconsider/pkg/consider"
+)
+
+// Finding is the structured representation of an issue, with its location resolved.
+type Finding struct {
+	// File is the name of the file that contains the issue.
+	File string `json:"file"`
+	// Line is the line of the first character of the issue, starting at 1.
+	Line int `json:"line"`
+	// Column is the column of the first character of the issue, in bytes, starting at 1.
+	Column int `json:"column"`
+	// EndLine is the line of the end of the issue.
+	EndLine int `json:"endLine"`
+	// EndColumn is the column just after the last character of the issue.
+	EndColumn int `json:"endColumn"`
+
+	// Context describes where the phrase was found, such as "Type name" or "Comment".
+	Context string `json:"context"`
+	// Declaration is the name of the top-level declaration that contains the issue, if any.
+	Declaration string `json:"declaration,omitempty"`
+	// Found is the synonym of the phrase that was found.
+	Found string `json:"found,omitempty"`
+	// PhraseIndex is the index of the phrase within the settings, or -1 if the issue is not about a phrase.
+	PhraseIndex int `json:"phraseIndex"`
+	// PhraseID is the identifier of the phrase. Empty if the issue is not about a phrase.
+	PhraseID string `json:"phraseId,omitempty"`
+	// Severity is one of "error", "warning", or "info".
+	Severity string `json:"severity"`
+	// PhraseSeverity is the severity of the phrase, which differs from Severity if that is specific to the finding,
+	// such as for struct tags. Empty if the issue is not about a phrase.
+	PhraseSeverity string `json:"phraseSeverity,omitempty"`
+	// Synonyms are all the synonyms of the phrase.
+	Synonyms []string `json:"synonyms,omitempty"`
+	// Alternatives are the proposed replacements of the phrase.
+	Alternatives []string `json:"alternatives"`
+	// References are the references of the phrase, resolved from the settings.
+	References []consider.Reference `json:"references"`
+	// Message is the human-readable description of the issue.
+	Message string `json:"message"`
+}
+
+// FindingFor returns the finding of an issue, resolving its location with the given file set.
+func FindingFor(fset *token.FileSet, issue consider.Issue) Finding {
+	start := fset.Position(issue.Pos)
+	end := start
+	if issue.End.IsValid() {
+		end = fset.Position(issue.End)
+	}
+	finding := Finding{
+		File:      start.Filename,
+		Line:      start.Line,
+		Column:    start.Column,
+		EndLine:   end.Line,
+		EndColumn: end.Column,
+
+		Context:        issue.Context,
+		Declaration:    issue.Declaration,
+		Found:          issue.Found,
+		PhraseIndex:    issue.PhraseIndex,
+		PhraseID:       issue.PhraseID,
+		Severity:       issue.Severity,
+		PhraseSeverity: issue.PhraseSeverity,
+		Synonyms:       issue.Synonyms,
+		Alternatives:   issue.Alternatives,
+		References:     issue.References,
+		Message:        issue.Message,
+	}
+	if len(finding.Severity) == 0 {
+		finding.Severity = consider.SeverityWarning
+	}
+	if finding.Alternatives == nil {
+		finding.Alternatives = []string{}
+	}
+	if finding.References == nil {
+		finding.References = []consider.Reference{}
+	}
+	return finding
+}
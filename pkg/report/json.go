@@ -0,0 +1,21 @@
+package report
+
+import (
+	"encoding/json"
+	"io"
+)
+
+type jsonReport struct {
+	Findings []Finding `json:"findings"`
+}
+
+// WriteJSON writes the findings as one JSON document, with the findings listed in an array named "findings".
+func WriteJSON(w io.Writer, findings []Finding) error {
+	report := jsonReport{Findings: findings}
+	if report.Findings == nil {
+		report.Findings = []Finding{}
+	}
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(report)
+}
@@ -0,0 +1,297 @@
+package report_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"go/token"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/dertseha/goconsider/pkg/consider"
+	"github.com/dertseha/goconsider/pkg/report"
+)
+
+func someFindings() []report.Finding {
+	fset := token.NewFileSet()
+	file := fset.AddFile("some/file.go", -1, 100)
+	file.SetLines([]int{0, 20, 40})
+	issue := consider.Issue{
+		Pos:          file.Pos(25),
+		End:          file.Pos(29),
+		Message:      "Type name contains 'abcd'.",
+		Context:      "Type name",
+		Found:        "abcd",
+		PhraseIndex:  1,
+		Alternatives: []string{"efgh"},
+		References:   []consider.Reference{{Short: "ref", Long: "https://example.com"}},
+		Declaration:  "AbcdType",
+	}
+	return []report.Finding{report.FindingFor(fset, issue)}
+}
+
+func TestFindingForResolvesLocation(t *testing.T) {
+	finding := someFindings()[0]
+	if (finding.File != "some/file.go") || (finding.Line != 2) || (finding.Column != 6) {
+		t.Errorf("Unexpected start %s:%d:%d", finding.File, finding.Line, finding.Column)
+	}
+	if (finding.EndLine != 2) || (finding.EndColumn != 10) {
+		t.Errorf("Unexpected end %d:%d", finding.EndLine, finding.EndColumn)
+	}
+}
+
+func TestWriteJSON(t *testing.T) {
+	buf := bytes.NewBuffer(nil)
+	err := report.WriteJSON(buf, someFindings())
+	if err != nil {
+		t.Fatalf("Failed to write: %v", err)
+	}
+	var parsed struct {
+		Findings []report.Finding `json:"findings"`
+	}
+	if err := json.Unmarshal(buf.Bytes(), &parsed); err != nil {
+		t.Fatalf("Failed to parse result: %v", err)
+	}
+	if len(parsed.Findings) != 1 {
+		t.Fatalf("Unexpected findings: %v", parsed.Findings)
+	}
+	finding := parsed.Findings[0]
+	if (finding.Found != "abcd") || (finding.PhraseIndex != 1) || (finding.References[0].Long != "https://example.com") ||
+		(finding.Severity != consider.SeverityWarning) {
+		t.Errorf("Unexpected finding: %v", finding)
+	}
+}
+
+func TestWriteJSONWithoutFindingsHasEmptyList(t *testing.T) {
+	buf := bytes.NewBuffer(nil)
+	_ = report.WriteJSON(buf, nil)
+	if !bytes.Contains(buf.Bytes(), []byte(`"findings": []`)) {
+		t.Errorf("Unexpected output: %s", buf.String())
+	}
+}
+
+func TestWriteText(t *testing.T) {
+	buf := bytes.NewBuffer(nil)
+	_ = report.WriteText(buf, someFindings())
+	expected := "some/file.go:2:6: Type name contains 'abcd'.\n"
+	if buf.String() != expected {
+		t.Errorf("Unexpected output: %s", buf.String())
+	}
+}
+
+func TestWriteTextWithPhraseIdentifier(t *testing.T) {
+	buf := bytes.NewBuffer(nil)
+	findings := someFindings()
+	findings[0].PhraseID = "abcd"
+	_ = report.WriteText(buf, findings)
+	expected := "some/file.go:2:6: Type name contains 'abcd'. (abcd)\n"
+	if buf.String() != expected {
+		t.Errorf("Unexpected output: %s", buf.String())
+	}
+}
+
+func TestWriteSARIF(t *testing.T) {
+	buf := bytes.NewBuffer(nil)
+	findings := someFindings()
+	findings[0].File = "/base/some/file.go"
+	findings[0].PhraseID = "abcd"
+	findings[0].Synonyms = []string{"abcd", "abcds"}
+	err := report.WriteSARIF(buf, "/base", findings)
+	if err != nil {
+		t.Fatalf("Failed to write: %v", err)
+	}
+	var parsed struct {
+		Version string `json:"version"`
+		Runs    []struct {
+			Tool struct {
+				Driver struct {
+					Rules []struct {
+						ID      string `json:"id"`
+						HelpURI string `json:"helpUri"`
+					} `json:"rules"`
+				} `json:"driver"`
+			} `json:"tool"`
+			Results []struct {
+				RuleID    string `json:"ruleId"`
+				Locations []struct {
+					PhysicalLocation struct {
+						ArtifactLocation struct {
+							URI       string `json:"uri"`
+							URIBaseID string `json:"uriBaseId"`
+						} `json:"artifactLocation"`
+						Region struct {
+							StartLine   int `json:"startLine"`
+							StartColumn int `json:"startColumn"`
+						} `json:"region"`
+					} `json:"physicalLocation"`
+				} `json:"locations"`
+			} `json:"results"`
+		} `json:"runs"`
+	}
+	if err := json.Unmarshal(buf.Bytes(), &parsed); err != nil {
+		t.Fatalf("Failed to parse result: %v", err)
+	}
+	if (parsed.Version != "2.1.0") || (len(parsed.Runs) != 1) {
+		t.Fatalf("Unexpected log: %s", buf.String())
+	}
+	run := parsed.Runs[0]
+	if (len(run.Tool.Driver.Rules) != 1) || (run.Tool.Driver.Rules[0].ID != "abcd") ||
+		(run.Tool.Driver.Rules[0].HelpURI != "https://example.com") {
+		t.Errorf("Unexpected rules: %s", buf.String())
+	}
+	if (len(run.Results) != 1) || (run.Results[0].RuleID != "abcd") {
+		t.Fatalf("Unexpected results: %s", buf.String())
+	}
+	location := run.Results[0].Locations[0].PhysicalLocation
+	if (location.ArtifactLocation.URI != "some/file.go") || (location.ArtifactLocation.URIBaseID != "%SRCROOT%") {
+		t.Errorf("Unexpected artifact location: %v", location.ArtifactLocation)
+	}
+	if (location.Region.StartLine != 2) || (location.Region.StartColumn != 6) {
+		t.Errorf("Unexpected region: %v", location.Region)
+	}
+}
+
+func TestWriteSARIFUsesSeverityAsLevel(t *testing.T) {
+	levels := map[string]string{
+		consider.SeverityError:   `"level": "error"`,
+		consider.SeverityWarning: `"level": "warning"`,
+		consider.SeverityInfo:    `"level": "note"`,
+	}
+	for severity, expected := range levels {
+		buf := bytes.NewBuffer(nil)
+		findings := someFindings()
+		findings[0].Severity = severity
+		_ = report.WriteSARIF(buf, "", findings)
+		if !bytes.Contains(buf.Bytes(), []byte(expected)) {
+			t.Errorf("Severity %s is not written as level: %s", severity, buf.String())
+		}
+	}
+}
+
+func TestWriteSARIFUsesSeverityOfPhraseForRule(t *testing.T) {
+	buf := bytes.NewBuffer(nil)
+	findings := someFindings()
+	findings[0].PhraseID = "abcd"
+	findings[0].Severity = consider.SeverityInfo
+	findings[0].PhraseSeverity = consider.SeverityError
+	err := report.WriteSARIF(buf, "", findings)
+	if err != nil {
+		t.Fatalf("Failed to write: %v", err)
+	}
+	var parsed struct {
+		Runs []struct {
+			Tool struct {
+				Driver struct {
+					Rules []struct {
+						DefaultConfiguration struct {
+							Level string `json:"level"`
+						} `json:"defaultConfiguration"`
+					} `json:"rules"`
+				} `json:"driver"`
+			} `json:"tool"`
+			Results []struct {
+				Level string `json:"level"`
+			} `json:"results"`
+		} `json:"runs"`
+	}
+	if err := json.Unmarshal(buf.Bytes(), &parsed); err != nil {
+		t.Fatalf("Failed to parse result: %v", err)
+	}
+	run := parsed.Runs[0]
+	if (len(run.Tool.Driver.Rules) != 1) || (run.Tool.Driver.Rules[0].DefaultConfiguration.Level != "error") {
+		t.Errorf("Unexpected rules: %s", buf.String())
+	}
+	if (len(run.Results) != 1) || (run.Results[0].Level != "note") {
+		t.Errorf("Unexpected results: %s", buf.String())
+	}
+}
+
+func TestWriteSARIFCountsColumnsInCodePoints(t *testing.T) {
+	filename := filepath.Join(t.TempDir(), "file.go")
+	if err := os.WriteFile(filename, []byte("package file\n\n// \u00e4\u00f6 abcd\n"), 0o600); err != nil {
+		t.Fatalf("Failed to write file: %v", err)
+	}
+	findings := someFindings()
+	findings[0].File = filename
+	findings[0].Line, findings[0].Column, findings[0].EndLine, findings[0].EndColumn = 3, 9, 3, 13
+	buf := bytes.NewBuffer(nil)
+	if err := report.WriteSARIF(buf, "", findings); err != nil {
+		t.Fatalf("Failed to write: %v", err)
+	}
+	var parsed struct {
+		Runs []struct {
+			ColumnKind string `json:"columnKind"`
+			Results    []struct {
+				Locations []struct {
+					PhysicalLocation struct {
+						Region struct {
+							StartColumn int `json:"startColumn"`
+							EndColumn   int `json:"endColumn"`
+						} `json:"region"`
+					} `json:"physicalLocation"`
+				} `json:"locations"`
+			} `json:"results"`
+		} `json:"runs"`
+	}
+	if err := json.Unmarshal(buf.Bytes(), &parsed); err != nil {
+		t.Fatalf("Failed to parse result: %v", err)
+	}
+	run := parsed.Runs[0]
+	if run.ColumnKind != "unicodeCodePoints" {
+		t.Errorf("Unexpected column kind %s", run.ColumnKind)
+	}
+	region := run.Results[0].Locations[0].PhysicalLocation.Region
+	if (region.StartColumn != 7) || (region.EndColumn != 11) {
+		t.Errorf("Unexpected region: %v", region)
+	}
+}
+
+func TestWriteCheckstyle(t *testing.T) {
+	buf := bytes.NewBuffer(nil)
+	findings := someFindings()
+	findings[0].PhraseID = "abcd"
+	err := report.WriteCheckstyle(buf, findings)
+	if err != nil {
+		t.Fatalf("Failed to write: %v", err)
+	}
+	expected := `<?xml version="1.0" encoding="UTF-8"?>
+<checkstyle version="8.0">
+  <file name="some/file.go">
+    <error line="2" column="6" severity="warning" message="Type name contains &#39;abcd&#39;." source="goconsider.abcd"></error>
+  </file>
+</checkstyle>
+`
+	if buf.String() != expected {
+		t.Errorf("Unexpected output: %s", buf.String())
+	}
+}
+
+func TestWriteJUnit(t *testing.T) {
+	buf := bytes.NewBuffer(nil)
+	findings := someFindings()
+	findings[0].PhraseID = "abcd"
+	err := report.WriteJUnit(buf, findings)
+	if err != nil {
+		t.Fatalf("Failed to write: %v", err)
+	}
+	expected := `<?xml version="1.0" encoding="UTF-8"?>
+<testsuites name="goconsider" tests="1" failures="1">
+  <testsuite name="some/file.go" tests="1" failures="1">
+    <testcase name="some/file.go:2:6: Type name" classname="goconsider.abcd">
+      <failure message="Type name contains &#39;abcd&#39;." type="goconsider.abcd">some/file.go:2:6: Type name contains &#39;abcd&#39;.</failure>
+    </testcase>
+  </testsuite>
+</testsuites>
+`
+	if buf.String() != expected {
+		t.Errorf("Unexpected output: %s", buf.String())
+	}
+}
+
+func TestWriteJUnitWithoutFindingsHasPassedTest(t *testing.T) {
+	buf := bytes.NewBuffer(nil)
+	_ = report.WriteJUnit(buf, nil)
+	if !bytes.Contains(buf.Bytes(), []byte(`<testsuites name="goconsider" tests="1" failures="0">`)) {
+		t.Errorf("Unexpected output: %s", buf.String())
+	}
+}
@@ -0,0 +1,284 @@
+package report
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/dertseha/goconsider/internal/version"
+	"github.com/dertseha/goconsider/pkg/consider"
+)
+
+const (
+	sarifSchema  = "https://json.schemastore.org/sarif-2.1.0.json"
+	sarifVersion = "2.1.0"
+	sarifBaseID  = "%SRCROOT%"
+	// sarifColumnKind specifies that columns count Unicode code points, instead of the default UTF-16 code units.
+	sarifColumnKind = "unicodeCodePoints"
+
+	toolName           = "goconsider"
+	toolInformationURI = "https://github.com/dertseha/goconsider"
+
+	// unusedDirectiveRuleID is the rule of findings that are not about a phrase.
+	unusedDirectiveRuleID = "unused-directive"
+)
+
+type sarifLog struct {
+	Schema  string     `json:"$schema"`
+	Version string     `json:"version"`
+	Runs    []sarifRun `json:"runs"`
+}
+
+type sarifRun struct {
+	Tool               sarifTool                   `json:"tool"`
+	OriginalURIBaseIDs map[string]sarifArtifactURI `json:"originalUriBaseIds,omitempty"`
+	ColumnKind         string                      `json:"columnKind"`
+	Results            []sarifResult               `json:"results"`
+}
+
+type sarifTool struct {
+	Driver sarifDriver `json:"driver"`
+}
+
+type sarifDriver struct {
+	Name           string      `json:"name"`
+	InformationURI string      `json:"informationUri"`
+	Version        string      `json:"version"`
+	Rules          []sarifRule `json:"rules"`
+}
+
+type sarifRule struct {
+	ID                   string                 `json:"id"`
+	ShortDescription     sarifMessage           `json:"shortDescription"`
+	HelpURI              string                 `json:"helpUri,omitempty"`
+	Help                 sarifMessage           `json:"help"`
+	DefaultConfiguration sarifRuleConfiguration `json:"defaultConfiguration"`
+}
+
+type sarifRuleConfiguration struct {
+	Level string `json:"level"`
+}
+
+type sarifMessage struct {
+	Text     string `json:"text"`
+	Markdown string `json:"markdown,omitempty"`
+}
+
+type sarifResult struct {
+	RuleID    string          `json:"ruleId"`
+	RuleIndex int             `json:"ruleIndex"`
+	Level     string          `json:"level"`
+	Message   sarifMessage    `json:"message"`
+	Locations []sarifLocation `json:"locations"`
+}
+
+type sarifLocation struct {
+	PhysicalLocation sarifPhysicalLocation `json:"physicalLocation"`
+}
+
+type sarifPhysicalLocation struct {
+	ArtifactLocation sarifArtifactURI `json:"artifactLocation"`
+	Region           sarifRegion      `json:"region"`
+}
+
+type sarifArtifactURI struct {
+	URI       string `json:"uri"`
+	URIBaseID string `json:"uriBaseId,omitempty"`
+}
+
+type sarifRegion struct {
+	StartLine   int `json:"startLine"`
+	StartColumn int `json:"startColumn"`
+	EndLine     int `json:"endLine"`
+	EndColumn   int `json:"endColumn"`
+}
+
+// WriteSARIF writes the findings as a SARIF 2.1.0 log with a single run.
+//
+// Each phrase of the findings becomes a rule, identified by the identifier of the phrase.
+// The default level of a rule is the severity of its phrase, and the level of each result that of its finding.
+// The first resolved reference of a phrase becomes the help URI of the rule, and the alternatives its help text.
+// Files within baseDir are listed relative to it, using the base identifier "%SRCROOT%".
+// If baseDir is empty, all files are listed with their absolute path.
+// Columns are given in Unicode code points, as determined from the content of the files. If a file cannot be read,
+// the columns of its findings remain in bytes.
+func WriteSARIF(w io.Writer, baseDir string, findings []Finding) error {
+	columns := codePointColumns{lines: make(map[string][]string)}
+	run := sarifRun{
+		Tool: sarifTool{Driver: sarifDriver{
+			Name:           toolName,
+			InformationURI: toolInformationURI,
+			Version:        version.Version().CoreWithPreRelease,
+			Rules:          []sarifRule{},
+		}},
+		Results:    []sarifResult{},
+		ColumnKind: sarifColumnKind,
+	}
+	if len(baseDir) != 0 {
+		run.OriginalURIBaseIDs = map[string]sarifArtifactURI{sarifBaseID: {URI: fileURI(baseDir) + "/"}}
+	}
+	ruleIndices := make(map[string]int)
+	for _, finding := range findings {
+		ruleID := finding.PhraseID
+		if finding.PhraseIndex < 0 {
+			ruleID = unusedDirectiveRuleID
+		}
+		ruleIndex, known := ruleIndices[ruleID]
+		if !known {
+			ruleIndex = len(run.Tool.Driver.Rules)
+			ruleIndices[ruleID] = ruleIndex
+			run.Tool.Driver.Rules = append(run.Tool.Driver.Rules, sarifRuleFor(ruleID, finding))
+		}
+		run.Results = append(run.Results, sarifResult{
+			RuleID:    ruleID,
+			RuleIndex: ruleIndex,
+			Level:     sarifLevelOf(finding.Severity),
+			Message:   sarifMessage{Text: finding.Message},
+			Locations: []sarifLocation{{PhysicalLocation: sarifPhysicalLocation{
+				ArtifactLocation: artifactLocation(baseDir, finding.File),
+				Region: sarifRegion{
+					StartLine:   finding.Line,
+					StartColumn: columns.of(finding.File, finding.Line, finding.Column),
+					EndLine:     finding.EndLine,
+					EndColumn:   columns.of(finding.File, finding.EndLine, finding.EndColumn),
+				},
+			}}},
+		})
+	}
+	log := sarifLog{
+		Schema:  sarifSchema,
+		Version: sarifVersion,
+		Runs:    []sarifRun{run},
+	}
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(log)
+}
+
+// codePointColumns converts columns in bytes to columns in Unicode code points.
+// The lines of the files are read once, when first needed.
+type codePointColumns struct {
+	lines map[string][]string
+}
+
+// of returns the column in code points for given column in bytes, both starting at 1.
+// The column is returned unchanged if the line is not known.
+func (columns codePointColumns) of(filename string, line, byteColumn int) int {
+	lines, known := columns.lines[filename]
+	if !known {
+		if data, err := os.ReadFile(filename); err == nil {
+			lines = strings.Split(string(data), "\n")
+		}
+		columns.lines[filename] = lines
+	}
+	if (line < 1) || (line > len(lines)) || (byteColumn < 1) || (byteColumn-1 > len(lines[line-1])) {
+		return byteColumn
+	}
+	return utf8.RuneCountInString(lines[line-1][:byteColumn-1]) + 1
+}
+
+func sarifRuleFor(ruleID string, finding Finding) sarifRule {
+	severity := finding.PhraseSeverity
+	if len(severity) == 0 {
+		severity = finding.Severity
+	}
+	rule := sarifRule{
+		ID:                   ruleID,
+		DefaultConfiguration: sarifRuleConfiguration{Level: sarifLevelOf(severity)},
+	}
+	if finding.PhraseIndex < 0 {
+		rule.ShortDescription = sarifMessage{Text: "Unused goconsider directive"}
+		rule.Help = sarifMessage{Text: "The directive did not suppress any finding and can be removed."}
+		return rule
+	}
+	rule.ShortDescription = sarifMessage{Text: "Avoid " + quotedList(finding.Synonyms)}
+	help := "Consider rephrasing to something else."
+	if len(finding.Alternatives) == 1 {
+		help = "Consider rephrasing to " + quotedList(finding.Alternatives) + "."
+	} else if len(finding.Alternatives) > 1 {
+		help = "Consider rephrasing to one of " + quotedList(finding.Alternatives) + "."
+	}
+	markdown := help
+	if len(finding.References) > 0 {
+		help += " See also " + referenceList(finding) + "."
+		markdown += "\n\nSee also:\n"
+	}
+	for _, ref := range finding.References {
+		long := ref.Long
+		if len(long) == 0 {
+			long = ref.Short
+		}
+		if (len(rule.HelpURI) == 0) && isURL(long) {
+			rule.HelpURI = long
+		}
+		markdown += "\n* " + markdownReference(ref.Short, long)
+	}
+	rule.Help = sarifMessage{Text: help, Markdown: markdown}
+	return rule
+}
+
+// sarifLevelOf returns the SARIF level of given severity.
+func sarifLevelOf(severity string) string {
+	switch severity {
+	case consider.SeverityError:
+		return "error"
+	case consider.SeverityInfo:
+		return "note"
+	default:
+		return "warning"
+	}
+}
+
+func quotedList(list []string) string {
+	quoted := make([]string, 0, len(list))
+	for _, entry := range list {
+		quoted = append(quoted, "'"+entry+"'")
+	}
+	return strings.Join(quoted, ", ")
+}
+
+func referenceList(finding Finding) string {
+	refs := make([]string, 0, len(finding.References))
+	for _, ref := range finding.References {
+		if len(ref.Long) != 0 {
+			refs = append(refs, ref.Long)
+		} else {
+			refs = append(refs, ref.Short)
+		}
+	}
+	return strings.Join(refs, ", ")
+}
+
+func markdownReference(short, long string) string {
+	if isURL(long) {
+		return fmt.Sprintf("[%s](%s)", short, long)
+	}
+	return long
+}
+
+func isURL(s string) bool {
+	parsed, err := url.Parse(s)
+	return (err == nil) && ((parsed.Scheme == "http") || (parsed.Scheme == "https"))
+}
+
+func artifactLocation(baseDir, filename string) sarifArtifactURI {
+	if len(baseDir) != 0 {
+		if relative, err := filepath.Rel(baseDir, filename); (err == nil) && !strings.HasPrefix(relative, "..") {
+			return sarifArtifactURI{URI: (&url.URL{Path: filepath.ToSlash(relative)}).String(), URIBaseID: sarifBaseID}
+		}
+	}
+	return sarifArtifactURI{URI: fileURI(filename)}
+}
+
+func fileURI(filename string) string {
+	path := filepath.ToSlash(filename)
+	if !strings.HasPrefix(path, "/") {
+		path = "/" + path
+	}
+	return (&url.URL{Scheme: "file", Path: path}).String()
+}
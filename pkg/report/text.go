@@ -0,0 +1,21 @@
+package report
+
+import (
+	"fmt"
+	"io"
+)
+
+// WriteText writes the findings in the same format as the go/analysis checkers, one line per finding,
+// followed by the identifier of the phrase: "file:line:column: message (phrase-id)".
+func WriteText(w io.Writer, findings []Finding) error {
+	for _, finding := range findings {
+		message := finding.Message
+		if len(finding.PhraseID) != 0 {
+			message += " (" + finding.PhraseID + ")"
+		}
+		if _, err := fmt.Fprintf(w, "%s:%d:%d: %s\n", finding.File, finding.Line, finding.Column, message); err != nil {
+			return err
+		}
+	}
+	return nil
+}
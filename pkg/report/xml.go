@@ -0,0 +1,155 @@
+package report
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+)
+
+const (
+	checkstyleVersion = "8.0"
+	sourcePrefix      = toolName + "."
+)
+
+type checkstyleReport struct {
+	XMLName xml.Name         `xml:"checkstyle"`
+	Version string           `xml:"version,attr"`
+	Files   []checkstyleFile `xml:"file"`
+}
+
+type checkstyleFile struct {
+	Name   string            `xml:"name,attr"`
+	Errors []checkstyleError `xml:"error"`
+}
+
+type checkstyleError struct {
+	Line     int    `xml:"line,attr"`
+	Column   int    `xml:"column,attr"`
+	Severity string `xml:"severity,attr"`
+	Message  string `xml:"message,attr"`
+	Source   string `xml:"source,attr"`
+}
+
+// WriteCheckstyle writes the findings in the checkstyle XML format, grouped by file.
+// The source of each error is the identifier of the phrase, prefixed with "goconsider.".
+func WriteCheckstyle(w io.Writer, findings []Finding) error {
+	report := checkstyleReport{Version: checkstyleVersion}
+	for _, group := range groupedByFile(findings) {
+		file := checkstyleFile{Name: group.name}
+		for _, finding := range group.findings {
+			file.Errors = append(file.Errors, checkstyleError{
+				Line:     finding.Line,
+				Column:   finding.Column,
+				Severity: finding.Severity,
+				Message:  finding.Message,
+				Source:   sourceOf(finding),
+			})
+		}
+		report.Files = append(report.Files, file)
+	}
+	return writeXML(w, report)
+}
+
+type junitTestSuites struct {
+	XMLName  xml.Name         `xml:"testsuites"`
+	Name     string           `xml:"name,attr"`
+	Tests    int              `xml:"tests,attr"`
+	Failures int              `xml:"failures,attr"`
+	Suites   []junitTestSuite `xml:"testsuite"`
+}
+
+type junitTestSuite struct {
+	Name      string          `xml:"name,attr"`
+	Tests     int             `xml:"tests,attr"`
+	Failures  int             `xml:"failures,attr"`
+	TestCases []junitTestCase `xml:"testcase"`
+}
+
+type junitTestCase struct {
+	Name      string        `xml:"name,attr"`
+	ClassName string        `xml:"classname,attr"`
+	Failure   *junitFailure `xml:"failure,omitempty"`
+}
+
+type junitFailure struct {
+	Message string `xml:"message,attr"`
+	Type    string `xml:"type,attr"`
+	Content string `xml:",chardata"`
+}
+
+// WriteJUnit writes the findings in the JUnit XML format. Each file becomes a test suite, and each finding
+// a failed test case. Without any findings, a single passed test case is written, so that gating
+// tools see a successful run.
+func WriteJUnit(w io.Writer, findings []Finding) error {
+	report := junitTestSuites{Name: toolName}
+	for _, group := range groupedByFile(findings) {
+		suite := junitTestSuite{Name: group.name}
+		for _, finding := range group.findings {
+			location := fmt.Sprintf("%s:%d:%d", finding.File, finding.Line, finding.Column)
+			suite.TestCases = append(suite.TestCases, junitTestCase{
+				Name:      location + ": " + finding.Context,
+				ClassName: sourceOf(finding),
+				Failure: &junitFailure{
+					Message: finding.Message,
+					Type:    sourceOf(finding),
+					Content: location + ": " + finding.Message,
+				},
+			})
+		}
+		suite.Tests = len(suite.TestCases)
+		suite.Failures = len(suite.TestCases)
+		report.Suites = append(report.Suites, suite)
+		report.Tests += suite.Tests
+		report.Failures += suite.Failures
+	}
+	if len(report.Suites) == 0 {
+		report.Suites = append(report.Suites, junitTestSuite{
+			Name:      toolName,
+			Tests:     1,
+			TestCases: []junitTestCase{{Name: "no findings", ClassName: toolName}},
+		})
+		report.Tests = 1
+	}
+	return writeXML(w, report)
+}
+
+func writeXML(w io.Writer, v interface{}) error {
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+	encoder := xml.NewEncoder(w)
+	encoder.Indent("", "  ")
+	if err := encoder.Encode(v); err != nil {
+		return err
+	}
+	_, err := io.WriteString(w, "\n")
+	return err
+}
+
+func sourceOf(finding Finding) string {
+	if finding.PhraseIndex < 0 {
+		return sourcePrefix + unusedDirectiveRuleID
+	}
+	return sourcePrefix + finding.PhraseID
+}
+
+type fileFindings struct {
+	name     string
+	findings []Finding
+}
+
+// groupedByFile returns the findings grouped by their file, in the order the files first appear.
+func groupedByFile(findings []Finding) []fileFindings {
+	var groups []fileFindings
+	indices := make(map[string]int)
+	for _, finding := range findings {
+		index, known := indices[finding.File]
+		if !known {
+			index = len(groups)
+			indices[finding.File] = index
+			groups = append(groups, fileFindings{name: finding.File})
+		}
+		groups[index].findings = append(groups[index].findings, finding)
+	}
+	return groups
+}
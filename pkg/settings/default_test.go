@@ -3,6 +3,7 @@ package settings_test
 import (
 	"testing"
 
+	"github.com/dertseha/goconsider/pkg/consider"
 	"github.com/dertseha/goconsider/pkg/settings"
 )
 
@@ -36,3 +37,27 @@ func TestDefaultSettingsHaveReferences(t *testing.T) {
 		}
 	}
 }
+
+func TestDefaultSettingsHaveKnownSeverities(t *testing.T) {
+	s := settings.Default()
+	for index, phrase := range s.Phrases {
+		if _, known := consider.SeverityRank(s.SeverityOf(phrase)); !known {
+			t.Errorf("Phrase at index %d has unknown severity '%s'.", index, phrase.Severity)
+		}
+	}
+}
+
+func TestDefaultSettingsHaveTags(t *testing.T) {
+	s := settings.Default()
+	for index, phrase := range s.Phrases {
+		if len(phrase.Tags) == 0 {
+			t.Errorf("Phrase at index %d has no tags.", index)
+		}
+	}
+}
+
+func TestDefaultSettingsAreValid(t *testing.T) {
+	if err := settings.Default().Validate(); err != nil {
+		t.Errorf("Default settings are not valid: %v", err)
+	}
+}
@@ -0,0 +1,74 @@
+package settings
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/dertseha/goconsider/pkg/consider"
+)
+
+// ExtendsDefault is the entry in the Extends list of settings that refers to the default settings.
+const ExtendsDefault = "default"
+
+var errExtendsCycle = errors.New("settings extend themselves")
+
+// FromFile reads the settings from the YAML file with given name.
+//
+// The file is checked as described for FromYaml, with problems reported as *ValidationError.
+// Relative paths within the settings, such as that of the baseline, are resolved from the directory of the file.
+// The settings listed in Extends are read first, with relative filenames also resolved from that directory,
+// and are merged in the given order. The settings of the file itself are merged last, see Merge.
+func FromFile(filename string) (consider.Settings, error) {
+	s, _, err := fromFile(filename, nil)
+	return s, err
+}
+
+// fromFile reads the settings file and additionally returns the references used by its own phrases.
+func fromFile(filename string, visiting []string) (consider.Settings, []located, error) {
+	absolute, err := filepath.Abs(filename)
+	if err != nil {
+		return consider.Settings{}, nil, err
+	}
+	if containsString(visiting, absolute) {
+		return consider.Settings{}, nil, fmt.Errorf("%w: %s", errExtendsCycle, filename)
+	}
+	data, err := os.ReadFile(filename)
+	if err != nil {
+		return consider.Settings{}, nil, err
+	}
+	s, references, problems := decode(data)
+	if len(problems) != 0 {
+		return consider.Settings{}, nil, validationErrorFor(filename, problems)
+	}
+	dir := filepath.Dir(filename)
+	resolvePath(&s.Baseline, dir)
+	resolvePath(&s.Formatting.TemplateFile, dir)
+	resolvePath(&s.Formatting.CatalogFile, dir)
+	if len(s.Extends) == 0 {
+		return s, references, nil
+	}
+
+	var result consider.Settings
+	for _, extended := range s.Extends {
+		var base consider.Settings
+		if extended == ExtendsDefault {
+			base = Default()
+		} else {
+			resolvePath(&extended, dir)
+			base, _, err = fromFile(extended, append(visiting, absolute))
+			if err != nil {
+				return consider.Settings{}, nil, err
+			}
+		}
+		result = Merge(result, base)
+	}
+	return Merge(result, s), references, nil
+}
+
+func resolvePath(path *string, dir string) {
+	if (len(*path) != 0) && !filepath.IsAbs(*path) {
+		*path = filepath.Join(dir, *path)
+	}
+}
@@ -0,0 +1,86 @@
+package settings_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/dertseha/goconsider/pkg/settings"
+)
+
+func writeFile(t *testing.T, filename string, content string) {
+	t.Helper()
+	if err := os.MkdirAll(filepath.Dir(filename), 0o755); err != nil {
+		t.Fatalf("Failed to create directory: %v", err)
+	}
+	if err := os.WriteFile(filename, []byte(content), 0o600); err != nil {
+		t.Fatalf("Failed to write file: %v", err)
+	}
+}
+
+func TestFromFileResolvesRelativePaths(t *testing.T) {
+	dir := t.TempDir()
+	filename := filepath.Join(dir, "settings.yaml")
+	writeFile(t, filename, "baseline: known.yaml\nformatting:\n  templateFile: message.gotext\n")
+	s, err := settings.FromFile(filename)
+	if err != nil {
+		t.Fatalf("Failed to read: %v", err)
+	}
+	if s.Baseline != filepath.Join(dir, "known.yaml") {
+		t.Errorf("Unexpected baseline %s", s.Baseline)
+	}
+	if s.Formatting.TemplateFile != filepath.Join(dir, "message.gotext") {
+		t.Errorf("Unexpected template file %s", s.Formatting.TemplateFile)
+	}
+}
+
+func TestFromFileExtendsDefaultAndOtherFiles(t *testing.T) {
+	dir := t.TempDir()
+	writeFile(t, filepath.Join(dir, "shared", "org.yaml"), `
+baseline: known.yaml
+phrases:
+  - id: org-term
+    synonyms: [abcd]
+`)
+	filename := filepath.Join(dir, "project", ".goconsider.yaml")
+	writeFile(t, filename, `
+extends: [default, ../shared/org.yaml]
+removePhrases: [grandfathered]
+phrases:
+  - synonyms: [efgh]
+`)
+	s, err := settings.FromFile(filename)
+	if err != nil {
+		t.Fatalf("Failed to read: %v", err)
+	}
+	defaults := settings.Default()
+	if len(s.Phrases) != len(defaults.Phrases)+1 {
+		t.Errorf("Unexpected number of phrases: %d", len(s.Phrases))
+	}
+	ids := make(map[string]bool)
+	for _, phrase := range s.Phrases {
+		ids[phrase.Identifier()] = true
+	}
+	if !ids["org-term"] || !ids["efgh"] || !ids["slave"] || ids["grandfathered"] {
+		t.Errorf("Unexpected phrases: %v", ids)
+	}
+	if len(s.References) != len(defaults.References) {
+		t.Errorf("Unexpected references: %v", s.References)
+	}
+	if s.Baseline != filepath.Join(dir, "shared", "known.yaml") {
+		t.Errorf("Baseline of extended file is not resolved from its directory: %s", s.Baseline)
+	}
+	if len(s.Extends) != 0 {
+		t.Errorf("Extends remains in result")
+	}
+}
+
+func TestFromFileRejectsCycles(t *testing.T) {
+	dir := t.TempDir()
+	writeFile(t, filepath.Join(dir, "a.yaml"), "extends: [b.yaml]\n")
+	writeFile(t, filepath.Join(dir, "b.yaml"), "extends: [a.yaml]\n")
+	_, err := settings.FromFile(filepath.Join(dir, "a.yaml"))
+	if err == nil {
+		t.Errorf("Expected error for cycle")
+	}
+}
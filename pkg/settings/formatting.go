@@ -0,0 +1,44 @@
+package settings
+
+import (
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/dertseha/goconsider/pkg/consider"
+)
+
+var (
+	errTemplateAmbiguous = errors.New("only one of formatting template and templateFile can be set")
+	errCatalogAmbiguous  = errors.New("only one of formatting catalog and catalogFile can be set")
+)
+
+// LoadFormattingFiles returns the settings with the message template and the catalog loaded from their files,
+// if these are set.
+func LoadFormattingFiles(s consider.Settings) (consider.Settings, error) {
+	if len(s.Formatting.TemplateFile) != 0 {
+		if len(s.Formatting.Template) != 0 {
+			return s, errTemplateAmbiguous
+		}
+		data, err := os.ReadFile(s.Formatting.TemplateFile)
+		if err != nil {
+			return s, fmt.Errorf("failed to read template file: %w", err)
+		}
+		s.Formatting.Template = string(data)
+	}
+	if len(s.Formatting.CatalogFile) != 0 {
+		if len(s.Formatting.Catalog) != 0 {
+			return s, errCatalogAmbiguous
+		}
+		data, err := os.ReadFile(s.Formatting.CatalogFile)
+		if err != nil {
+			return s, fmt.Errorf("failed to read catalog file: %w", err)
+		}
+		catalog, err := CatalogFromYaml(data)
+		if err != nil {
+			return s, fmt.Errorf("failed to parse catalog file: %w", err)
+		}
+		s.Formatting.Catalog = catalog
+	}
+	return s, nil
+}
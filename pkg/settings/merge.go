@@ -0,0 +1,124 @@
+package settings
+
+import (
+	"github.com/dertseha/goconsider/pkg/consider"
+)
+
+// Merge returns the settings of the extension applied on top of the base settings.
+//
+// References are combined, with those of the extension taking precedence for the same key.
+// Phrases listed in RemovePhrases of the extension are removed from the base. Phrases of the extension
+// replace those of the base with the same identifier, and are appended otherwise.
+// Path rules are appended. Formatting is merged per field, with the template and the catalog each taken
+// together with their file counterpart. All other values of the extension replace those of the base if they are set.
+//
+// The Extends and RemovePhrases fields of the result are empty.
+func Merge(base, extension consider.Settings) consider.Settings {
+	result := base
+	result.Extends = nil
+	result.RemovePhrases = nil
+
+	result.References = make(map[string]string)
+	for key, value := range base.References {
+		result.References[key] = value
+	}
+	for key, value := range extension.References {
+		result.References[key] = value
+	}
+	result.Phrases = mergePhrases(base.Phrases, extension.Phrases, extension.RemovePhrases)
+	result.PathRules = append(append([]consider.PathRule{}, base.PathRules...), extension.PathRules...)
+	result.Formatting = mergeFormatting(base.Formatting, extension.Formatting)
+
+	if extension.Fixes.RenameExported != nil {
+		result.Fixes.RenameExported = extension.Fixes.RenameExported
+	}
+	if extension.Directives.ReportUnused != nil {
+		result.Directives.ReportUnused = extension.Directives.ReportUnused
+	}
+	if extension.SkipGenerated != nil {
+		result.SkipGenerated = extension.SkipGenerated
+	}
+	if extension.CheckGeneratedComments != nil {
+		result.CheckGeneratedComments = extension.CheckGeneratedComments
+	}
+	overrideString(&result.ExternalNames.Severity, extension.ExternalNames.Severity)
+	if extension.StructTags.Check != nil {
+		result.StructTags.Check = extension.StructTags.Check
+	}
+	overrideString(&result.StructTags.Severity, extension.StructTags.Severity)
+	if extension.CheckStrings != nil {
+		result.CheckStrings = extension.CheckStrings
+	}
+	overrideList(&result.StringFunctions.Errors, extension.StringFunctions.Errors)
+	overrideList(&result.StringFunctions.Logs, extension.StringFunctions.Logs)
+	overrideList(&result.StringFunctions.Tests, extension.StringFunctions.Tests)
+	overrideList(&result.StringFunctions.Help, extension.StringFunctions.Help)
+	overrideString(&result.Occurrences, extension.Occurrences)
+	overrideString(&result.Severity, extension.Severity)
+	overrideString(&result.Baseline, extension.Baseline)
+	overrideList(&result.Include, extension.Include)
+	overrideList(&result.Exclude, extension.Exclude)
+	overrideList(&result.EnableTags, extension.EnableTags)
+	overrideList(&result.DisableTags, extension.DisableTags)
+	return result
+}
+
+func mergePhrases(base, extension []consider.Phrase, removed []string) []consider.Phrase {
+	var result []consider.Phrase
+	indices := make(map[string]int)
+	for _, phrase := range base {
+		id := phrase.Identifier()
+		if containsString(removed, id) {
+			continue
+		}
+		indices[id] = len(result)
+		result = append(result, phrase)
+	}
+	for _, phrase := range extension {
+		if index, exists := indices[phrase.Identifier()]; exists {
+			result[index] = phrase
+			continue
+		}
+		indices[phrase.Identifier()] = len(result)
+		result = append(result, phrase)
+	}
+	return result
+}
+
+func mergeFormatting(base, extension consider.Formatting) consider.Formatting {
+	result := base
+	if extension.WithReferences != nil {
+		result.WithReferences = extension.WithReferences
+	}
+	if (len(extension.Template) != 0) || (len(extension.TemplateFile) != 0) {
+		result.Template = extension.Template
+		result.TemplateFile = extension.TemplateFile
+	}
+	overrideString(&result.Locale, extension.Locale)
+	if (len(extension.Catalog) != 0) || (len(extension.CatalogFile) != 0) {
+		result.Catalog = extension.Catalog
+		result.CatalogFile = extension.CatalogFile
+	}
+	return result
+}
+
+func overrideString(value *string, extension string) {
+	if len(extension) != 0 {
+		*value = extension
+	}
+}
+
+func overrideList(list *[]string, extension []string) {
+	if extension != nil {
+		*list = extension
+	}
+}
+
+func containsString(list []string, s string) bool {
+	for _, entry := range list {
+		if entry == s {
+			return true
+		}
+	}
+	return false
+}
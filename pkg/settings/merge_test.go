@@ -0,0 +1,86 @@
+package settings_test
+
+import (
+	"testing"
+
+	"github.com/dertseha/goconsider/pkg/consider"
+	"github.com/dertseha/goconsider/pkg/settings"
+)
+
+func TestMergeCombinesReferences(t *testing.T) {
+	base := consider.Settings{References: map[string]string{"a": "base", "b": "base"}}
+	extension := consider.Settings{References: map[string]string{"b": "extension", "c": "extension"}}
+	merged := settings.Merge(base, extension)
+	expected := map[string]string{"a": "base", "b": "extension", "c": "extension"}
+	if len(merged.References) != len(expected) {
+		t.Fatalf("Unexpected references %v", merged.References)
+	}
+	for key, value := range expected {
+		if merged.References[key] != value {
+			t.Errorf("Unexpected reference %s: %s", key, merged.References[key])
+		}
+	}
+	if base.References["b"] != "base" {
+		t.Errorf("Base was modified")
+	}
+}
+
+func TestMergePhrases(t *testing.T) {
+	base := consider.Settings{
+		Phrases: []consider.Phrase{
+			{Synonyms: []string{"abcd"}},
+			{ID: "second", Synonyms: []string{"efgh"}},
+			{Synonyms: []string{"bad"}},
+		},
+	}
+	extension := consider.Settings{
+		Phrases: []consider.Phrase{
+			{ID: "second", Synonyms: []string{"efgh", "efghs"}, Alternatives: []string{"ijkl"}},
+			{Synonyms: []string{"mnop"}},
+		},
+		RemovePhrases: []string{"bad"},
+	}
+	merged := settings.Merge(base, extension)
+	ids := []string{"abcd", "second", "mnop"}
+	if len(merged.Phrases) != len(ids) {
+		t.Fatalf("Unexpected phrases %v", merged.Phrases)
+	}
+	for index, id := range ids {
+		if merged.Phrases[index].Identifier() != id {
+			t.Errorf("Unexpected phrase at %d: %v", index, merged.Phrases[index])
+		}
+	}
+	if len(merged.Phrases[1].Alternatives) != 1 {
+		t.Errorf("Phrase was not replaced: %v", merged.Phrases[1])
+	}
+	if len(merged.RemovePhrases) != 0 {
+		t.Errorf("Removed phrases remain in result")
+	}
+}
+
+func TestMergeFormatting(t *testing.T) {
+	withReferences := true
+	base := consider.Settings{
+		Formatting: consider.Formatting{WithReferences: &withReferences, TemplateFile: "base.gotext", Locale: "de"},
+	}
+	extension := consider.Settings{Formatting: consider.Formatting{Template: "{{.Found}}"}}
+	merged := settings.Merge(base, extension).Formatting
+	if (merged.WithReferences == nil) || !*merged.WithReferences {
+		t.Errorf("WithReferences was not kept")
+	}
+	if (merged.Template != "{{.Found}}") || (len(merged.TemplateFile) != 0) {
+		t.Errorf("Template was not replaced: %v", merged)
+	}
+	if merged.Locale != "de" {
+		t.Errorf("Locale was not kept: %v", merged)
+	}
+}
+
+func TestMergeOverridesSetValues(t *testing.T) {
+	base := consider.Settings{Severity: consider.SeverityError, Exclude: []string{"vendor/"}, Occurrences: "all"}
+	extension := consider.Settings{Severity: consider.SeverityInfo, Exclude: []string{}}
+	merged := settings.Merge(base, extension)
+	if (merged.Severity != consider.SeverityInfo) || (len(merged.Exclude) != 0) || (merged.Occurrences != "all") {
+		t.Errorf("Unexpected result %v", merged)
+	}
+}
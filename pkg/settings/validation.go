@@ -0,0 +1,309 @@
+package settings
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/dertseha/goconsider/internal/text"
+	"github.com/dertseha/goconsider/pkg/consider"
+	"gopkg.in/yaml.v3"
+)
+
+// Problem describes a single problem of settings, located in their YAML source.
+type Problem struct {
+	// Line is the line of the problem, starting at 1. It is 0 if the problem has no specific location.
+	Line int
+	// Column is the column of the problem, starting at 1. It is 0 if the problem has no specific column.
+	Column int
+	// Message describes the problem.
+	Message string
+}
+
+func (p Problem) String() string {
+	switch {
+	case p.Line == 0:
+		return p.Message
+	case p.Column == 0:
+		return fmt.Sprintf("%d: %s", p.Line, p.Message)
+	default:
+		return fmt.Sprintf("%d:%d: %s", p.Line, p.Column, p.Message)
+	}
+}
+
+// ValidationError is returned for settings that have problems.
+type ValidationError struct {
+	// Filename is the name of the settings file. It is empty for settings that were not read from a file.
+	Filename string
+	// Problems lists all found problems, in the order of their location.
+	Problems []Problem
+}
+
+func (err *ValidationError) Error() string {
+	lines := make([]string, 0, len(err.Problems))
+	for _, problem := range err.Problems {
+		location := problem.String()
+		if len(err.Filename) != 0 {
+			separator := ":"
+			if problem.Line == 0 {
+				separator = ": "
+			}
+			location = err.Filename + separator + location
+		}
+		lines = append(lines, location)
+	}
+	return strings.Join(lines, "\n")
+}
+
+// Validate reads the settings file with given name and returns an error if the settings have any problems.
+//
+// Next to the checks when reading settings files, see FromYaml, this reports references of phrases that look like
+// keys, yet are not defined in the references of the settings, including those of extended settings.
+// Single words are typically meant as keys, while links or descriptions are used directly.
+// Validate also loads the files of the formatting, and applies consider.Settings.Validate.
+// The returned error is a *ValidationError if the file could be read.
+func Validate(filename string) error {
+	s, references, err := fromFile(filename, nil)
+	if err != nil {
+		return err
+	}
+	if err := validationErrorFor(filename, checkReferences(references, s.References)); err != nil {
+		return err
+	}
+	s, err = LoadFormattingFiles(s)
+	if err == nil {
+		err = s.Validate()
+	}
+	if err != nil {
+		return &ValidationError{Filename: filename, Problems: []Problem{{Message: err.Error()}}}
+	}
+	return nil
+}
+
+// located is a value of the YAML source, together with its location.
+type located struct {
+	value  string
+	line   int
+	column int
+}
+
+func locatedFrom(node *yaml.Node) located {
+	return located{value: node.Value, line: node.Line, column: node.Column}
+}
+
+func (l located) problem(format string, args ...interface{}) Problem {
+	return Problem{Line: l.line, Column: l.column, Message: fmt.Sprintf(format, args...)}
+}
+
+// decode parses the raw YAML data into settings. It returns the problems of the data, together with the references
+// of the phrases. These can only be checked once the settings are merged with the settings they extend.
+func decode(data []byte) (consider.Settings, []located, []Problem) {
+	var s consider.Settings
+	var root yaml.Node
+	if err := yaml.Unmarshal(data, &root); err != nil {
+		return s, nil, problemsOf(err)
+	}
+	if len(root.Content) == 0 {
+		return s, nil, nil
+	}
+	document := root.Content[0]
+	problems := unknownFields(document, reflect.TypeOf(s))
+	if err := document.Decode(&s); err != nil {
+		problems = append(problems, problemsOf(err)...)
+	}
+	references, phraseProblems := checkPhrases(valueOf(document, "phrases"))
+	problems = append(problems, phraseProblems...)
+	return s, references, problems
+}
+
+var errorLinePattern = regexp.MustCompile(`^(?:yaml: )?line (\d+): (.*)$`)
+
+// problemsOf converts the errors of the YAML package, which only carry a line, if any.
+func problemsOf(err error) []Problem {
+	messages := []string{err.Error()}
+	var typeErr *yaml.TypeError
+	if errors.As(err, &typeErr) {
+		messages = typeErr.Errors
+	}
+	problems := make([]Problem, 0, len(messages))
+	for _, message := range messages {
+		problem := Problem{Message: message}
+		if match := errorLinePattern.FindStringSubmatch(message); match != nil {
+			problem.Line, _ = strconv.Atoi(match[1])
+			problem.Message = match[2]
+		}
+		problems = append(problems, problem)
+	}
+	return problems
+}
+
+// unknownFields returns problems for all keys of mappings that do not correspond to a field of the given type.
+func unknownFields(node *yaml.Node, t reflect.Type) []Problem {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if node.Kind == yaml.AliasNode {
+		return unknownFields(node.Alias, t)
+	}
+	var problems []Problem
+	switch {
+	case (t.Kind() == reflect.Struct) && (node.Kind == yaml.MappingNode):
+		fields := yamlFields(t)
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			key, value := node.Content[i], node.Content[i+1]
+			field, known := fields[key.Value]
+			if !known {
+				problems = append(problems, locatedFrom(key).problem("unknown field '%s'%s", key.Value, suggestionFor(key.Value, fields)))
+				continue
+			}
+			problems = append(problems, unknownFields(value, field.Type)...)
+		}
+	case ((t.Kind() == reflect.Slice) && (node.Kind == yaml.SequenceNode)) ||
+		((t.Kind() == reflect.Map) && (node.Kind == yaml.MappingNode)):
+		for _, element := range node.Content {
+			problems = append(problems, unknownFields(element, t.Elem())...)
+		}
+	}
+	return problems
+}
+
+func yamlFields(t reflect.Type) map[string]reflect.StructField {
+	fields := make(map[string]reflect.StructField)
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		name, _, _ := strings.Cut(field.Tag.Get("yaml"), ",")
+		if len(name) == 0 {
+			name = strings.ToLower(field.Name)
+		}
+		if name != "-" {
+			fields[name] = field
+		}
+	}
+	return fields
+}
+
+// suggestionFor proposes a known field for a misspelled one, for typical mistakes such as a missing plural or
+// a different case. A field that only differs in case is preferred, otherwise the shortest field that the name is
+// a prefix of, or that is a prefix of the name, is proposed. Fields of the same length are taken in sorted order.
+func suggestionFor(name string, fields map[string]reflect.StructField) string {
+	lowerName := strings.ToLower(name)
+	var candidates []string
+	for known := range fields {
+		lowerKnown := strings.ToLower(known)
+		if lowerKnown == lowerName {
+			return fmt.Sprintf(", did you mean '%s'?", known)
+		}
+		if strings.HasPrefix(lowerKnown, lowerName) || strings.HasPrefix(lowerName, lowerKnown) {
+			candidates = append(candidates, known)
+		}
+	}
+	if len(candidates) == 0 {
+		return ""
+	}
+	sort.Slice(candidates, func(a, b int) bool {
+		if len(candidates[a]) != len(candidates[b]) {
+			return len(candidates[a]) < len(candidates[b])
+		}
+		return candidates[a] < candidates[b]
+	})
+	return fmt.Sprintf(", did you mean '%s'?", candidates[0])
+}
+
+// valueOf returns the value of given key within a mapping node, or nil if it does not exist.
+func valueOf(node *yaml.Node, key string) *yaml.Node {
+	if (node == nil) || (node.Kind != yaml.MappingNode) {
+		return nil
+	}
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		if node.Content[i].Value == key {
+			return node.Content[i+1]
+		}
+	}
+	return nil
+}
+
+// checkPhrases returns problems of the synonyms of the phrases, and the references of the phrases.
+func checkPhrases(phrases *yaml.Node) ([]located, []Problem) {
+	if (phrases == nil) || (phrases.Kind != yaml.SequenceNode) {
+		return nil, nil
+	}
+	var references []located
+	var problems []Problem
+	known := make(map[string]located)
+	for _, phrase := range phrases.Content {
+		if phrase.Kind != yaml.MappingNode {
+			continue
+		}
+		synonyms := valueOf(phrase, "synonyms")
+		if (synonyms == nil) || ((synonyms.Kind == yaml.SequenceNode) && (len(synonyms.Content) == 0)) {
+			problems = append(problems, locatedFrom(phrase).problem("phrase has no synonyms"))
+		} else if synonyms.Kind == yaml.SequenceNode {
+			for _, synonym := range synonyms.Content {
+				problems = append(problems, checkSynonym(locatedFrom(synonym), known)...)
+			}
+		}
+		if refs := valueOf(phrase, "references"); (refs != nil) && (refs.Kind == yaml.SequenceNode) {
+			for _, ref := range refs.Content {
+				references = append(references, locatedFrom(ref))
+			}
+		}
+	}
+	return references, problems
+}
+
+func checkSynonym(synonym located, known map[string]located) []Problem {
+	normalized := strings.Join(strings.Fields(synonym.value), " ")
+	if len(normalized) == 0 {
+		return []Problem{synonym.problem("empty synonym")}
+	}
+	var problems []Problem
+	if wordForm := strings.TrimSpace(text.Wordify(synonym.value)); wordForm != normalized {
+		if len(wordForm) == 0 {
+			problems = append(problems, synonym.problem("synonym '%s' can never match, as it has no words", synonym.value))
+		} else {
+			problems = append(problems, synonym.problem("synonym '%s' can never match, use its word form '%s'",
+				synonym.value, wordForm))
+		}
+	}
+	if previous, exists := known[normalized]; exists {
+		problems = append(problems, synonym.problem("duplicate synonym '%s', also at line %d", synonym.value, previous.line))
+	} else {
+		known[normalized] = synonym
+	}
+	return problems
+}
+
+// checkReferences returns problems for references that look like keys, yet are not found in the given references.
+// Other references, such as links or descriptions, are used directly.
+func checkReferences(used []located, references map[string]string) []Problem {
+	var problems []Problem
+	for _, ref := range used {
+		if _, known := references[ref.value]; known || !looksLikeKey(ref.value) {
+			continue
+		}
+		problems = append(problems, ref.problem("unknown reference key '%s'", ref.value))
+	}
+	return problems
+}
+
+func looksLikeKey(ref string) bool {
+	return (len(ref) != 0) && !strings.ContainsAny(ref, " \t:/.")
+}
+
+// validationErrorFor returns a *ValidationError with the problems sorted by location, or nil if there are none.
+func validationErrorFor(filename string, problems []Problem) error {
+	if len(problems) == 0 {
+		return nil
+	}
+	sort.SliceStable(problems, func(a, b int) bool {
+		if problems[a].Line != problems[b].Line {
+			return problems[a].Line < problems[b].Line
+		}
+		return problems[a].Column < problems[b].Column
+	})
+	return &ValidationError{Filename: filename, Problems: problems}
+}
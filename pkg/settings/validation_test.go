@@ -0,0 +1,117 @@
+package settings_test
+
+import (
+	"errors"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/dertseha/goconsider/pkg/settings"
+)
+
+func validationProblems(t *testing.T, content string) []string {
+	t.Helper()
+	filename := filepath.Join(t.TempDir(), "settings.yaml")
+	writeFile(t, filename, content)
+	err := settings.Validate(filename)
+	if err == nil {
+		return nil
+	}
+	var validationErr *settings.ValidationError
+	if !errors.As(err, &validationErr) {
+		t.Fatalf("Unexpected error type: %v", err)
+	}
+	if validationErr.Filename != filename {
+		t.Errorf("Unexpected filename %s", validationErr.Filename)
+	}
+	problems := make([]string, 0, len(validationErr.Problems))
+	for _, problem := range validationErr.Problems {
+		problems = append(problems, problem.String())
+	}
+	return problems
+}
+
+func verifyProblems(t *testing.T, content string, expected ...string) {
+	t.Helper()
+	problems := validationProblems(t, content)
+	if strings.Join(problems, "\n") != strings.Join(expected, "\n") {
+		t.Errorf("Unexpected problems:\n%s\nexpected:\n%s", strings.Join(problems, "\n"), strings.Join(expected, "\n"))
+	}
+}
+
+func TestValidateAcceptsValidSettings(t *testing.T) {
+	verifyProblems(t, `
+references:
+  guide: https://example.com
+phrases:
+  - synonyms: [abcd, efgh ijkl]
+    references: [guide, https://example.com/other]
+`)
+}
+
+func TestValidateReportsUnknownFieldsWithSuggestion(t *testing.T) {
+	verifyProblems(t, `
+phrases:
+  - synonym: [abcd]
+`,
+		"3:5: unknown field 'synonym', did you mean 'synonyms'?",
+		"3:5: phrase has no synonyms")
+}
+
+func TestValidateSuggestsShortestOfAmbiguousFields(t *testing.T) {
+	for run := 0; run < 10; run++ {
+		verifyProblems(t, `
+check: true
+Phrases: []
+`,
+			"2:1: unknown field 'check', did you mean 'checkStrings'?",
+			"3:1: unknown field 'Phrases', did you mean 'phrases'?")
+	}
+}
+
+func TestValidateReportsEmptyAndDuplicateSynonyms(t *testing.T) {
+	verifyProblems(t, `
+phrases:
+  - synonyms: [abcd, ""]
+  - synonyms: [abcd]
+`,
+		"3:22: empty synonym",
+		"4:16: duplicate synonym 'abcd', also at line 3")
+}
+
+func TestValidateReportsSynonymsThatNeverMatch(t *testing.T) {
+	verifyProblems(t, `
+phrases:
+  - synonyms: [AbcdEfgh, "--"]
+`,
+		"3:16: synonym 'AbcdEfgh' can never match, use its word form 'abcd efgh'",
+		"3:26: synonym '--' can never match, as it has no words")
+}
+
+func TestValidateReportsDanglingReferenceKeys(t *testing.T) {
+	verifyProblems(t, `
+references:
+  guide: https://example.com
+phrases:
+  - synonyms: [abcd]
+    references: [guid]
+`,
+		"6:18: unknown reference key 'guid'")
+}
+
+func TestValidateReportsTypeErrors(t *testing.T) {
+	problems := validationProblems(t, `
+phrases:
+  - synonyms: abcd
+`)
+	if (len(problems) != 1) || !strings.HasPrefix(problems[0], "3: cannot unmarshal") {
+		t.Errorf("Unexpected problems: %v", problems)
+	}
+}
+
+func TestFromYamlRejectsUnknownFields(t *testing.T) {
+	_, err := settings.FromYaml([]byte("phrase:\n  - synonyms: [abcd]\n"))
+	if err == nil {
+		t.Errorf("Expected error for unknown field")
+	}
+}
@@ -6,8 +6,17 @@ import (
 )
 
 // FromYaml parses the provided raw YAML data into a settings instance.
+//
+// The data is checked strictly: Unknown fields, values of the wrong type, empty or duplicate synonyms,
+// and synonyms that can never match are reported as a *ValidationError.
 func FromYaml(data []byte) (consider.Settings, error) {
-	var settings consider.Settings
-	err := yaml.Unmarshal(data, &settings)
-	return settings, err
+	s, _, problems := decode(data)
+	return s, validationErrorFor("", problems)
+}
+
+// CatalogFromYaml parses the provided raw YAML data into a catalog of messages, see consider.Formatting.Catalog.
+func CatalogFromYaml(data []byte) (map[string]string, error) {
+	var catalog map[string]string
+	err := yaml.Unmarshal(data, &catalog)
+	return catalog, err
 }
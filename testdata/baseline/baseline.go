@@ -0,0 +1,11 @@
+package baseline
+
+type AbcdKnown int
+
+func knownFunc() {
+	abcdFirst := 1
+	abcdSecond := abcdFirst // want `Identifier contains 'abcd', consider rephrasing to something else.`
+	_ = abcdSecond
+}
+
+type AbcdNew int // want `Type name contains 'abcd', consider rephrasing to something else.`
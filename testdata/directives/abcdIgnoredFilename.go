@@ -0,0 +1 @@
+package directives //goconsider:ignore abcd
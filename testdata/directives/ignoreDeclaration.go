@@ -0,0 +1,27 @@
+package directives
+
+// AbcdIgnoredType is completely ignored, including its documentation.
+//goconsider:ignore
+type AbcdIgnoredType struct {
+	AbcdMember int
+}
+
+//goconsider:ignore
+func abcdIgnoredFunc(abcdParam int) {
+	abcdLocal := abcdParam
+	_ = abcdLocal
+}
+
+func partiallyIgnoredFunc() {
+	//goconsider:ignore
+	abcdLocal := 1
+	abcdOther := abcdLocal // want `Identifier contains 'abcd', consider rephrasing to something else.`
+	_ = abcdOther
+}
+
+//goconsider:ignore efgh
+type AbcdStillReported int // want `Type name contains 'abcd', consider rephrasing to something else.`
+
+// The directive text itself is not checked.
+//goconsider:ignore abcd // the abcd word is meant here
+var unrelated = 0
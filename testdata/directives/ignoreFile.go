@@ -0,0 +1,7 @@
+//goconsider:file-ignore abcd
+
+package directives
+
+var abcdIgnoredInFile = 1
+
+var efghReported = 1 // want `Value name contains 'efgh', consider rephrasing to something else.`
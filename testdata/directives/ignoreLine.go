@@ -0,0 +1,15 @@
+package directives
+
+var abcdIgnoredInLine = 1 //goconsider:ignore
+
+var abcdIgnoredWithExplanation = 1 //goconsider:ignore // this is a domain term
+
+var abcdReportedNextLine = abcdIgnoredInLine // want `Value name contains 'abcd', consider rephrasing to something else.`
+
+var abcdEfghSpecific = 2 //goconsider:ignore abcd // want `Value name contains 'efgh', consider rephrasing to something else.`
+
+var abcdEfghList = 2 //goconsider:ignore efgh, abcd
+
+var abcdOtherSynonym = 3 //goconsider:ignore abcds
+
+var efghIgnoredByIdentifier = 4 //goconsider:ignore efgh-phrase
@@ -0,0 +1,5 @@
+package nested
+
+var abcdValue = 1 // want `Value name contains 'abcd', consider rephrasing to 'mnop'.`
+
+var efghValue = 2 // want `Value name contains 'efgh', consider rephrasing to something else.`
@@ -0,0 +1,5 @@
+package discovery
+
+var abcdValue = 1 // want `Value name contains 'abcd', consider rephrasing to 'ijkl'.`
+
+var efghValue = 2
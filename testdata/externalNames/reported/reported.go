@@ -0,0 +1,28 @@
+package reported
+
+import (
+	"sort"
+	_ "unsafe" // required for go:linkname
+)
+
+// Entries implements sort.Interface, which dictates the names of its methods.
+type Entries []int
+
+func sorted(entries Entries) Entries {
+	sort.Sort(entries)
+	return entries
+}
+
+func (entries Entries) Len() int           { return len(entries) }
+func (entries Entries) Less(a, b int) bool { return entries[a] < entries[b] }
+func (entries Entries) Swap(a, b int) { // want `Function name contains 'swap', consider rephrasing to 'exchange'. The name is required to implement sort.Interface.`
+	entries[a], entries[b] = entries[b], entries[a]
+}
+
+//export abcdExported
+func abcdExported() { // want `Function name contains 'abcd', consider rephrasing to something else. The name is exported to C.`
+}
+
+//go:linkname abcdLinked
+func abcdLinked() { // want `Function name contains 'abcd', consider rephrasing to something else. The name is referenced by a go:linkname directive.`
+}
@@ -0,0 +1,61 @@
+package suppressed
+
+import (
+	"sort"
+	_ "unsafe" // required for go:linkname
+)
+
+// Entries implements sort.Interface, which dictates the names of its methods.
+type Entries []int
+
+func sorted(entries Entries) Entries {
+	sort.Sort(entries)
+	return entries
+}
+
+func (entries Entries) Len() int           { return len(entries) }
+func (entries Entries) Less(a, b int) bool { return entries[a] < entries[b] }
+func (entries Entries) Swap(a, b int)      { entries[a], entries[b] = entries[b], entries[a] }
+
+// Pairs has the methods of sort.Interface, yet is never used as one. The names of its methods are not dictated.
+type Pairs []int
+
+func (pairs Pairs) Len() int           { return len(pairs) }
+func (pairs Pairs) Less(a, b int) bool { return pairs[a] < pairs[b] }
+func (pairs Pairs) Swap(a, b int) { // want `Function name contains 'swap', consider rephrasing to 'exchange'.`
+	pairs[a], pairs[b] = pairs[b], pairs[a]
+}
+
+// Items is used as sort.Interface by being returned as one.
+type Items []int
+
+func asInterface(items *Items) sort.Interface {
+	return items
+}
+
+func (items *Items) Len() int           { return len(*items) }
+func (items *Items) Less(a, b int) bool { return (*items)[a] < (*items)[b] }
+func (items *Items) Swap(a, b int)      { (*items)[a], (*items)[b] = (*items)[b], (*items)[a] }
+
+func (entries Entries) SwapAll() { // want `Function name contains 'swap', consider rephrasing to 'exchange'.`
+}
+
+type localSwapper interface {
+	SwapLocal() // want `Method name contains 'swap', consider rephrasing to 'exchange'.`
+}
+
+var _ localSwapper = Entries{}
+
+func (entries Entries) SwapLocal() { // want `Function name contains 'swap', consider rephrasing to 'exchange'.`
+}
+
+//export abcdExported
+func abcdExported() {
+}
+
+//go:linkname abcdLinked
+func abcdLinked() {
+}
+
+func abcdFree() { // want `Function name contains 'abcd', consider rephrasing to something else.`
+}
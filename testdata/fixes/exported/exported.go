@@ -0,0 +1,7 @@
+package exported
+
+type BadIndex int // want `Type name contains 'bad', consider rephrasing to 'good'.`
+
+func NextIndex(index BadIndex) BadIndex {
+	return index + 1
+}
@@ -0,0 +1,15 @@
+package fields
+
+type server struct {
+	badHost, goodHost string // want `Member name contains 'bad', consider rephrasing to one of \['good', 'fine one'\].`
+	badLabel          string // want `Member name contains 'bad', consider rephrasing to one of \['good', 'fine one'\].`
+}
+
+func (s server) goodLabel() string {
+	return s.badHost + s.goodHost + s.badLabel
+}
+
+var anonymous = struct {
+	badPort  int // want `Member name contains 'bad', consider rephrasing to one of \['good', 'fine one'\].`
+	goodPort int
+}{badPort: 1}
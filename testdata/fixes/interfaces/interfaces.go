@@ -0,0 +1,20 @@
+package interfaces
+
+type reader interface {
+	readBad() int // want `Method name contains 'bad', consider rephrasing to one of \['good', 'fine one'\].`
+}
+
+type source struct{}
+
+var _ reader = source{}
+
+func (source) readBad() int { // want `Function name contains 'bad', consider rephrasing to one of \['good', 'fine one'\].`
+	return 1
+}
+
+func (source) writeBad() { // want `Function name contains 'bad', consider rephrasing to one of \['good', 'fine one'\].`
+}
+
+func (s source) writeGood() {
+	s.writeBad()
+}
@@ -0,0 +1,21 @@
+package local
+
+type badIndex int // want `Type name contains 'bad', consider rephrasing to one of \['good', 'fine one'\].`
+
+func (index badIndex) next() badIndex {
+	return index + 1
+}
+
+const bad_limit = 10 // want `Value name contains 'bad', consider rephrasing to one of \['good', 'fine one'\].`
+
+func limit() int {
+	return bad_limit
+}
+
+var ExportedBadValue = 0 // want `Value name contains 'bad', consider rephrasing to one of \['good', 'fine one'\].`
+
+func conflicting() int {
+	goodName := 1
+	badName := 2 // want `Identifier contains 'bad', consider rephrasing to one of \['good', 'fine one'\].`
+	return goodName + badName
+}
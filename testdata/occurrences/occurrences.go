@@ -0,0 +1,10 @@
+package occurrences
+
+// This comment mentions abcd in this line, // want `Comment contains 'abcd'`
+// and abcd in this line, // want `Comment contains 'abcd'`
+// and twice more here: abcd and abcd. // want `Comment contains 'abcd'` `Comment contains 'abcd'`
+
+var abcdAndAbcd = 1 // want `Value name contains 'abcd'` `Value name contains 'abcd'`
+
+// The combined abcd efgh is found once, as it is longer. // want `Comment contains '[a]bcd efgh'`
+var combined = 2
@@ -0,0 +1,5 @@
+package paths
+
+var abcdValue = 1 // want `Value name contains 'abcd', consider rephrasing to something else.`
+
+var efghValue = 2
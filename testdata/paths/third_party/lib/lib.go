@@ -0,0 +1,5 @@
+package lib
+
+var abcdValue = 1
+
+var efghValue = 2
@@ -0,0 +1,5 @@
+package paths
+
+var abcdSpecialValue = 1
+
+var efghSpecialValue = 2
@@ -0,0 +1,25 @@
+package funclits
+
+func inSelector() {
+	_ = func(abcdArg string) handlers { return handlers{} }("").onEvent // want `Parameter name contains 'abcd', consider rephrasing to something else`
+}
+
+func inIndex(list []int) {
+	_ = list[func(abcdArg string) int { return 0 }("")] // want `Parameter name contains 'abcd', consider rephrasing to something else`
+}
+
+func inSlice(list []int) {
+	_ = list[func(abcdLow string) int { return 0 }(""):] // want `Parameter name contains 'abcd', consider rephrasing to something else`
+}
+
+func inTypeAssertion() {
+	_ = interface{}(func(abcdArg string) int { return 0 }).(handler) // want `Parameter name contains 'abcd', consider rephrasing to something else`
+}
+
+func inGenericIndex() {
+	_ = generic[int, handler](func(abcdArg string) int { return 0 }) // want `Parameter name contains 'abcd', consider rephrasing to something else`
+}
+
+func generic[T any, H any](h H) H {
+	return h
+}
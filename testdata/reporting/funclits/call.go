@@ -0,0 +1,16 @@
+package funclits
+
+func inCallArgument() {
+	call(func(abcdArg string) int { // want `Parameter name contains 'abcd', consider rephrasing to something else`
+		abcdLocal := len(abcdArg) // want `Identifier contains 'abcd', consider rephrasing to something else`
+		return abcdLocal
+	})
+}
+
+func inCalledFunction() {
+	func(abcdArg string) {}("") // want `Parameter name contains 'abcd', consider rephrasing to something else`
+}
+
+func inVariadicArguments() {
+	callAll(func(abcdArg string) int { return 0 }, func(string) int { return 0 }) // want `Parameter name contains 'abcd', consider rephrasing to something else`
+}
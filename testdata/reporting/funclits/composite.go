@@ -0,0 +1,19 @@
+package funclits
+
+var packageHandlers = handlers{
+	onEvent: func(abcdEvent string) int { return len(abcdEvent) }, // want `Parameter name contains 'abcd', consider rephrasing to something else`
+}
+
+func inCompositeLiteral() {
+	_ = handlers{
+		all: []handler{
+			func(abcdFirst string) int { return 0 }, // want `Parameter name contains 'abcd', consider rephrasing to something else`
+		},
+	}
+}
+
+func inKeyValue() {
+	_ = map[string]handler{
+		"key": func(abcdValue string) int { return 0 }, // want `Parameter name contains 'abcd', consider rephrasing to something else`
+	}
+}
@@ -0,0 +1,18 @@
+// Package funclits contains function literals within all kinds of expressions.
+// Identifiers declared in the literals are reported, while their uses are not.
+package funclits
+
+type handler func(string) int
+
+type handlers struct {
+	onEvent handler
+	all     []handler
+}
+
+func call(h handler) int {
+	return h("")
+}
+
+func callAll(h ...handler) int {
+	return len(h)
+}
@@ -0,0 +1,17 @@
+package funclits
+
+func inParentheses() {
+	_ = (func(abcdArg string) int { return 0 }) // want `Parameter name contains 'abcd', consider rephrasing to something else`
+}
+
+func inUnary() {
+	_ = !func(abcdArg string) bool { return true }("") // want `Parameter name contains 'abcd', consider rephrasing to something else`
+}
+
+func inBinary() {
+	_ = 1 + func(abcdArg string) int { return 0 }("") // want `Parameter name contains 'abcd', consider rephrasing to something else`
+}
+
+func inStar() {
+	_ = *func(abcdArg string) *int { return nil }("") // want `Parameter name contains 'abcd', consider rephrasing to something else`
+}
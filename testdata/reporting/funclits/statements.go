@@ -0,0 +1,31 @@
+package funclits
+
+var packageValue = call(func(abcdArg string) int { return 0 }) // want `Parameter name contains 'abcd', consider rephrasing to something else`
+
+func inStatements(ch chan handler, counter int, abcdUsed bool) { // want `Parameter name contains 'abcd', consider rephrasing to something else`
+	go call(func(abcdArg string) int { return 0 })                   // want `Parameter name contains 'abcd', consider rephrasing to something else`
+	defer call(func(abcdArg string) int { return 0 })                // want `Parameter name contains 'abcd', consider rephrasing to something else`
+	ch <- func(abcdArg string) int { return 0 }                      // want `Parameter name contains 'abcd', consider rephrasing to something else`
+	if abcdUsed && call(func(abcdArg string) int { return 0 }) > 0 { // want `Parameter name contains 'abcd', consider rephrasing to something else`
+		counter++
+	}
+	switch abcdUsed {
+	case call(func(abcdArg string) int { return 0 }) > 0: // want `Parameter name contains 'abcd', consider rephrasing to something else`
+	}
+	for abcdUsed && call(func(abcdArg string) int { return 0 }) > 0 { // want `Parameter name contains 'abcd', consider rephrasing to something else`
+		abcdUsed = false
+	}
+	for range []handler{func(abcdArg string) int { return 0 }} { // want `Parameter name contains 'abcd', consider rephrasing to something else`
+	}
+	for abcdIndex := range []int{} { // want `Identifier contains 'abcd', consider rephrasing to something else`
+		_ = abcdIndex
+	}
+	var abcdOuter int // want `Value name contains 'abcd', consider rephrasing to something else`
+	for abcdOuter = range []int{} {
+	}
+	_ = abcdOuter
+}
+
+func inReturn() handler {
+	return func(abcdArg string) int { return 0 } // want `Parameter name contains 'abcd', consider rephrasing to something else`
+}
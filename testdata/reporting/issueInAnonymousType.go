@@ -0,0 +1,57 @@
+package reporting
+
+var anonymousValue struct {
+	AbcdHost string // want `Member name contains 'abcd', consider rephrasing to something else`
+}
+
+var anonymousList = []struct {
+	abcdName string // want `Member name contains 'abcd', consider rephrasing to something else`
+}{
+	{abcdName: "first"},
+}
+
+var anonymousInterface interface {
+	AbcdMethod() // want `Method name contains 'abcd', consider rephrasing to something else`
+}
+
+var anonymousMap map[string]*struct{ abcdKey int } // want `Member name contains 'abcd', consider rephrasing to something else`
+
+var anonymousChannel chan func(abcdArg int) // want `Parameter name contains 'abcd', consider rephrasing to something else`
+
+type Nested struct {
+	Entries []struct {
+		AbcdEntry int // want `Member name contains 'abcd', consider rephrasing to something else`
+	}
+}
+
+func anonymousTypesInFunction(value interface{}) {
+	var local struct{ abcdLocal int } // want `Member name contains 'abcd', consider rephrasing to something else`
+	_ = local.abcdLocal
+
+	literal := []struct{ abcdLiteral int }{{abcdLiteral: 1}} // want `Member name contains 'abcd', consider rephrasing to something else`
+	_ = literal
+
+	converted := struct{ AbcdHost string }(anonymousValue) // want `Member name contains 'abcd', consider rephrasing to something else`
+	_ = converted.AbcdHost
+
+	pointer := (*struct{ AbcdHost string })(&anonymousValue) // want `Member name contains 'abcd', consider rephrasing to something else`
+	_ = pointer
+
+	asserted := value.(interface{ AbcdMethod() }) // want `Method name contains 'abcd', consider rephrasing to something else`
+	_ = asserted
+
+	switch value.(type) {
+	case func(abcdArg int): // want `Parameter name contains 'abcd', consider rephrasing to something else`
+	}
+
+	anonymousList = append(anonymousList, struct{ abcdName string }{}) // want `Member name contains 'abcd', consider rephrasing to something else`
+}
+
+func anonymousTypeArgument() {
+	_ = generic[struct{ abcdMember int }]() // want `Member name contains 'abcd', consider rephrasing to something else`
+}
+
+func generic[T any]() T {
+	var result T
+	return result
+}
@@ -0,0 +1,8 @@
+package reporting
+
+// want +4 `Comment contains 'abcd', consider rephrasing to something else`
+
+/*
+This is a block comment that contains
+the word abcd. It is reported in its line.
+*/
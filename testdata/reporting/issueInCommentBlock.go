@@ -1,7 +1,9 @@
 package reporting
 
-// This comment block // want `Comment contains 'a long phrase that may go across multiple lines', consider rephrasing to something else.`
+// want +4 `Comment contains 'a long phrase that may go across multiple lines', consider rephrasing to something else.`
+
+// This comment block
 // shall showcase that
 // a long phrase that
 // may go across multiple
-// lines is also detected
+// lines is also detected, and reported where it starts.
@@ -1,4 +1,4 @@
 package reporting
 
-// This is a freefloating comment that contains // want `Comment contains 'abcd', consider rephrasing to something else`
-// the word abcd.
+// This is a freefloating comment that contains
+// the word abcd. // want `Comment contains 'abcd', consider rephrasing to something else`
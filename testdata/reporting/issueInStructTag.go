@@ -0,0 +1,14 @@
+package reporting
+
+type Serialized struct {
+	Primary  int    `json:"abcd_id,omitempty"` // want `JSON field name contains 'abcd', consider rephrasing to something else`
+	Count    int    `yaml:"abcdCount"`         // want `YAML field name contains 'abcd', consider rephrasing to something else`
+	Column   string `db:"abcd"`                // want `Database column name contains 'abcd', consider rephrasing to something else`
+	Element  string `xml:"AbcdElement"`        // want `XML element name contains 'abcd', consider rephrasing to something else`
+	Key      string `toml:"abcd-key"`          // want `TOML key contains 'abcd', consider rephrasing to something else`
+	Other    string `custom:"with abcd"`       // want `Struct tag contains 'abcd', consider rephrasing to something else`
+	Multiple string `json:"first" yaml:"abcd"` // want `YAML field name contains 'abcd', consider rephrasing to something else`
+	Option   string `json:"abcd,omitempty"`    // want `JSON field name contains 'abcd', consider rephrasing to something else`
+	Document string `bson:"abcd,omitempty"`    // want `BSON field name contains 'abcd', consider rephrasing to something else`
+	Quoted   string "json:\"abcd\""            // want `JSON field name contains 'abcd', consider rephrasing to something else`
+}
@@ -0,0 +1,3 @@
+package catalog
+
+type abc struct{} // want `Nom de type contient 'abc', consider rephrasing to one of \['def', 'ghi'\].`
@@ -0,0 +1,6 @@
+package locale
+
+var abc = "" // want `Wertname enthält 'abc', erwäge eine Umformulierung zu 'def'. Siehe auch guide.`
+
+//goconsider:ignore // want `Unbenutzte goconsider-Direktive '//goconsider:ignore'.`
+var other = ""
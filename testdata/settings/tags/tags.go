@@ -0,0 +1,9 @@
+package tags
+
+var abc = "" // want `Value name contains 'abc', consider rephrasing to something else.`
+
+var def = ""
+
+var ghi = ""
+
+var jkl = ""
@@ -0,0 +1,7 @@
+package template
+
+var someAbcValue = "" // want `template.go: Value name '[A]bc' in some[A]bcValue, see https://wiki.example.com/[a]bc-rule`
+
+func Function() {
+	// The ABC is here. // want `template.go: Comment 'ABC' in Function, see https://wiki.example.com/abc-rule`
+}
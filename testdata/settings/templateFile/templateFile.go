@@ -0,0 +1,3 @@
+package templatefile
+
+type abcType struct{} // want `Type name uses 'abc'. Prefer: def or ghi.`
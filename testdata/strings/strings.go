@@ -0,0 +1,25 @@
+package strings
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"log"
+)
+
+const greeting = "hello abcd" // want `String literal contains 'abcd', consider rephrasing to something else`
+
+var errSample = errors.New("abcd failed") // want `Error message contains 'abcd', consider rephrasing to something else`
+
+var verbose = flag.Bool("verbose", false, "print every abcd") // want `Help text contains 'abcd', consider rephrasing to something else`
+
+func run(logger *log.Logger) error {
+	log.Printf("starting %s for abcd", greeting) // want `Log message contains 'abcd', consider rephrasing to something else`
+	logger.Println("abcd done")                  // want `Log message contains 'abcd', consider rephrasing to something else`
+	_ = fmt.Sprintf("custom abcd")               // want `Error message contains 'abcd', consider rephrasing to something else`
+	_ = fmt.Sprint("other abcd")                 // want `String literal contains 'abcd', consider rephrasing to something else`
+	_ = "escaped \t\"quote\" abcd"               // want `String literal contains 'abcd', consider rephrasing to something else`
+	_ = `raw
+abcd` // want `String literal contains 'abcd', consider rephrasing to something else`
+	return fmt.Errorf("wrapped abcd: %w", errSample) // want `Error message contains 'abcd', consider rephrasing to something else`
+}
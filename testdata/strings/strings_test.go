@@ -0,0 +1,9 @@
+package strings
+
+import "testing"
+
+func TestRun(t *testing.T) {
+	t.Run("with abcd", func(t *testing.T) { // want `Test name contains 'abcd', consider rephrasing to something else`
+		t.Logf("no abcd") // want `String literal contains 'abcd', consider rephrasing to something else`
+	})
+}
@@ -0,0 +1,3 @@
+//goconsider:file-ignore efgh // want `Unused goconsider directive '//goconsider:file-ignore efgh'.`
+
+package unusedDirectives
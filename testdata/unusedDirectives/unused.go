@@ -0,0 +1,10 @@
+package unusedDirectives
+
+var abcdIgnored = 1 //goconsider:ignore
+
+var safeName = 1 //goconsider:ignore // want `Unused goconsider directive '//goconsider:ignore'.`
+
+var abcdOther = 1 //goconsider:ignore efgh // want `Value name contains 'abcd', consider rephrasing to something else.` `Unused goconsider directive '//goconsider:ignore efgh'.`
+
+//goconsider:ignore abcd // want `Unused goconsider directive '//goconsider:ignore abcd'.`
+type SafeType int